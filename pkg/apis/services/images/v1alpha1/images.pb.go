@@ -7,9 +7,13 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
+	control "github.com/moby/buildkit/api/services/control"
+	pb "github.com/moby/buildkit/solver/pb"
+	github_com_moby_buildkit_util_entitlements "github.com/moby/buildkit/util/entitlements"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -34,17 +38,293 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// mirrors moby.buildkit.v1.SolveRequest
+type ImageBuildRequest struct {
+	Ref            string                                                   `protobuf:"bytes,1,opt,name=Ref,proto3" json:"Ref,omitempty"`
+	Definition     *pb.Definition                                           `protobuf:"bytes,2,opt,name=Definition,proto3" json:"Definition,omitempty"`
+	Exporter       string                                                   `protobuf:"bytes,3,opt,name=Exporter,proto3" json:"Exporter,omitempty"`
+	ExporterAttrs  map[string]string                                        `protobuf:"bytes,4,rep,name=ExporterAttrs,proto3" json:"ExporterAttrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Session        string                                                   `protobuf:"bytes,5,opt,name=Session,proto3" json:"Session,omitempty"`
+	Frontend       string                                                   `protobuf:"bytes,6,opt,name=Frontend,proto3" json:"Frontend,omitempty"`
+	FrontendAttrs  map[string]string                                        `protobuf:"bytes,7,rep,name=FrontendAttrs,proto3" json:"FrontendAttrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cache          control.CacheOptions                                     `protobuf:"bytes,8,opt,name=Cache,proto3" json:"Cache"`
+	Entitlements   []github_com_moby_buildkit_util_entitlements.Entitlement `protobuf:"bytes,9,rep,name=Entitlements,proto3,customtype=github.com/moby/buildkit/util/entitlements.Entitlement" json:"Entitlements,omitempty"`
+	FrontendInputs map[string]*pb.Definition                                `protobuf:"bytes,10,rep,name=FrontendInputs,proto3" json:"FrontendInputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Squash the layers created by the build into one, rewriting the exported image(s).
+	Squash               bool     `protobuf:"varint,11,opt,name=Squash,proto3" json:"Squash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImageBuildRequest) Reset()      { *m = ImageBuildRequest{} }
+func (*ImageBuildRequest) ProtoMessage() {}
+func (*ImageBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{0}
+}
+func (m *ImageBuildRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageBuildRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImageBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageBuildRequest.Merge(m, src)
+}
+func (m *ImageBuildRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageBuildRequest proto.InternalMessageInfo
+
+func (m *ImageBuildRequest) GetRef() string {
+	if m != nil {
+		return m.Ref
+	}
+	return ""
+}
+
+func (m *ImageBuildRequest) GetDefinition() *pb.Definition {
+	if m != nil {
+		return m.Definition
+	}
+	return nil
+}
+
+func (m *ImageBuildRequest) GetExporter() string {
+	if m != nil {
+		return m.Exporter
+	}
+	return ""
+}
+
+func (m *ImageBuildRequest) GetExporterAttrs() map[string]string {
+	if m != nil {
+		return m.ExporterAttrs
+	}
+	return nil
+}
+
+func (m *ImageBuildRequest) GetSession() string {
+	if m != nil {
+		return m.Session
+	}
+	return ""
+}
+
+func (m *ImageBuildRequest) GetFrontend() string {
+	if m != nil {
+		return m.Frontend
+	}
+	return ""
+}
+
+func (m *ImageBuildRequest) GetFrontendAttrs() map[string]string {
+	if m != nil {
+		return m.FrontendAttrs
+	}
+	return nil
+}
+
+func (m *ImageBuildRequest) GetCache() control.CacheOptions {
+	if m != nil {
+		return m.Cache
+	}
+	return control.CacheOptions{}
+}
+
+func (m *ImageBuildRequest) GetFrontendInputs() map[string]*pb.Definition {
+	if m != nil {
+		return m.FrontendInputs
+	}
+	return nil
+}
+
+func (m *ImageBuildRequest) GetSquash() bool {
+	if m != nil {
+		return m.Squash
+	}
+	return false
+}
+
+type ImageBuildResponse struct {
+	ExporterResponse     map[string]string `protobuf:"bytes,1,rep,name=ExporterResponse,proto3" json:"ExporterResponse,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImageBuildResponse) Reset()      { *m = ImageBuildResponse{} }
+func (*ImageBuildResponse) ProtoMessage() {}
+func (*ImageBuildResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{1}
+}
+func (m *ImageBuildResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageBuildResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageBuildResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImageBuildResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageBuildResponse.Merge(m, src)
+}
+func (m *ImageBuildResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageBuildResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageBuildResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageBuildResponse proto.InternalMessageInfo
+
+func (m *ImageBuildResponse) GetExporterResponse() map[string]string {
+	if m != nil {
+		return m.ExporterResponse
+	}
+	return nil
+}
+
+type ImageBuildStatusRequest struct {
+	Ref                  string   `protobuf:"bytes,1,opt,name=Ref,proto3" json:"Ref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImageBuildStatusRequest) Reset()      { *m = ImageBuildStatusRequest{} }
+func (*ImageBuildStatusRequest) ProtoMessage() {}
+func (*ImageBuildStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{2}
+}
+func (m *ImageBuildStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageBuildStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageBuildStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImageBuildStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageBuildStatusRequest.Merge(m, src)
+}
+func (m *ImageBuildStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageBuildStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageBuildStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageBuildStatusRequest proto.InternalMessageInfo
+
+func (m *ImageBuildStatusRequest) GetRef() string {
+	if m != nil {
+		return m.Ref
+	}
+	return ""
+}
+
+// mirrors moby.buildkit.v1.StatusResponse
+type ImageBuildStatusResponse struct {
+	Vertexes             []*control.Vertex       `protobuf:"bytes,1,rep,name=vertexes,proto3" json:"vertexes,omitempty"`
+	Statuses             []*control.VertexStatus `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Logs                 []*control.VertexLog    `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ImageBuildStatusResponse) Reset()      { *m = ImageBuildStatusResponse{} }
+func (*ImageBuildStatusResponse) ProtoMessage() {}
+func (*ImageBuildStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{3}
+}
+func (m *ImageBuildStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageBuildStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageBuildStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImageBuildStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageBuildStatusResponse.Merge(m, src)
+}
+func (m *ImageBuildStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageBuildStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageBuildStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageBuildStatusResponse proto.InternalMessageInfo
+
+func (m *ImageBuildStatusResponse) GetVertexes() []*control.Vertex {
+	if m != nil {
+		return m.Vertexes
+	}
+	return nil
+}
+
+func (m *ImageBuildStatusResponse) GetStatuses() []*control.VertexStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *ImageBuildStatusResponse) GetLogs() []*control.VertexLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
 type ImageListRequest struct {
 	// Filter to list images.
-	Filter               *v1alpha2.ImageFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Filter *v1alpha2.ImageFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Docker-style filters, e.g. dangling=true, reference=glob, label=key=value, before=image, since=image, until=24h.
+	Filters []string `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	// References (possibly globs) of the images to list, matching any.
+	References           []string `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ImageListRequest) Reset()      { *m = ImageListRequest{} }
 func (*ImageListRequest) ProtoMessage() {}
 func (*ImageListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{0}
+	return fileDescriptor_51c65cb1807988f9, []int{4}
 }
 func (m *ImageListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -80,17 +360,33 @@ func (m *ImageListRequest) GetFilter() *v1alpha2.ImageFilter {
 	return nil
 }
 
+func (m *ImageListRequest) GetFilters() []string {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *ImageListRequest) GetReferences() []string {
+	if m != nil {
+		return m.References
+	}
+	return nil
+}
+
 type ImageListResponse struct {
 	// List of images.
-	Images               []*v1alpha2.Image `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Images []*v1alpha2.Image `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	// Metadata of the listed images, keyed by image id.
+	Metadata             map[string]*ImageMetadata `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *ImageListResponse) Reset()      { *m = ImageListResponse{} }
 func (*ImageListResponse) ProtoMessage() {}
 func (*ImageListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{1}
+	return fileDescriptor_51c65cb1807988f9, []int{5}
 }
 func (m *ImageListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,17 +422,88 @@ func (m *ImageListResponse) GetImages() []*v1alpha2.Image {
 	return nil
 }
 
+func (m *ImageListResponse) GetMetadata() map[string]*ImageMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type ImageMetadata struct {
+	Created time.Time         `protobuf:"bytes,1,opt,name=created,proto3,stdtime" json:"created"`
+	Labels  map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Platforms for which content is available, more than one for multi-platform images.
+	Platforms            []string `protobuf:"bytes,3,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImageMetadata) Reset()      { *m = ImageMetadata{} }
+func (*ImageMetadata) ProtoMessage() {}
+func (*ImageMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{6}
+}
+func (m *ImageMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImageMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageMetadata.Merge(m, src)
+}
+func (m *ImageMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageMetadata proto.InternalMessageInfo
+
+func (m *ImageMetadata) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *ImageMetadata) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ImageMetadata) GetPlatforms() []string {
+	if m != nil {
+		return m.Platforms
+	}
+	return nil
+}
+
 type ImagePullRequest struct {
-	Image                *v1alpha2.ImageSpec  `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Auth                 *v1alpha2.AuthConfig `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Image *v1alpha2.ImageSpec  `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Auth  *v1alpha2.AuthConfig `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	// identifies the pull so that its progress can be tracked
+	RequestId            string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ImagePullRequest) Reset()      { *m = ImagePullRequest{} }
 func (*ImagePullRequest) ProtoMessage() {}
 func (*ImagePullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{2}
+	return fileDescriptor_51c65cb1807988f9, []int{7}
 }
 func (m *ImagePullRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,8 +546,16 @@ func (m *ImagePullRequest) GetAuth() *v1alpha2.AuthConfig {
 	return nil
 }
 
+func (m *ImagePullRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
 type ImagePullResponse struct {
 	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	JobId                string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -188,7 +563,7 @@ type ImagePullResponse struct {
 func (m *ImagePullResponse) Reset()      { *m = ImagePullResponse{} }
 func (*ImagePullResponse) ProtoMessage() {}
 func (*ImagePullResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{3}
+	return fileDescriptor_51c65cb1807988f9, []int{8}
 }
 func (m *ImagePullResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,17 +599,26 @@ func (m *ImagePullResponse) GetImage() string {
 	return ""
 }
 
+func (m *ImagePullResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
 type ImagePushRequest struct {
-	Image                *v1alpha2.ImageSpec  `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Auth                 *v1alpha2.AuthConfig `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Image *v1alpha2.ImageSpec  `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Auth  *v1alpha2.AuthConfig `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	// identifies the push so that its progress can be tracked
+	RequestId            string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ImagePushRequest) Reset()      { *m = ImagePushRequest{} }
 func (*ImagePushRequest) ProtoMessage() {}
 func (*ImagePushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{4}
+	return fileDescriptor_51c65cb1807988f9, []int{9}
 }
 func (m *ImagePushRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,8 +661,16 @@ func (m *ImagePushRequest) GetAuth() *v1alpha2.AuthConfig {
 	return nil
 }
 
-type ImagePushResponse struct {
+func (m *ImagePushRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type ImagePushResponse struct {
 	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	JobId                string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -286,7 +678,7 @@ type ImagePushResponse struct {
 func (m *ImagePushResponse) Reset()      { *m = ImagePushResponse{} }
 func (*ImagePushResponse) ProtoMessage() {}
 func (*ImagePushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{5}
+	return fileDescriptor_51c65cb1807988f9, []int{10}
 }
 func (m *ImagePushResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,8 +714,17 @@ func (m *ImagePushResponse) GetImage() string {
 	return ""
 }
 
+func (m *ImagePushResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
 type ImageProgressRequest struct {
-	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// when set, progress is reported for the pull or push with the same request id
+	RequestId            string   `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -331,7 +732,7 @@ type ImageProgressRequest struct {
 func (m *ImageProgressRequest) Reset()      { *m = ImageProgressRequest{} }
 func (*ImageProgressRequest) ProtoMessage() {}
 func (*ImageProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{6}
+	return fileDescriptor_51c65cb1807988f9, []int{11}
 }
 func (m *ImageProgressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,6 +768,13 @@ func (m *ImageProgressRequest) GetImage() string {
 	return ""
 }
 
+func (m *ImageProgressRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
 type ImageProgressResponse struct {
 	Status               []ImageStatus `protobuf:"bytes,1,rep,name=status,proto3" json:"status"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -376,7 +784,7 @@ type ImageProgressResponse struct {
 func (m *ImageProgressResponse) Reset()      { *m = ImageProgressResponse{} }
 func (*ImageProgressResponse) ProtoMessage() {}
 func (*ImageProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{7}
+	return fileDescriptor_51c65cb1807988f9, []int{12}
 }
 func (m *ImageProgressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +835,7 @@ type ImageStatus struct {
 func (m *ImageStatus) Reset()      { *m = ImageStatus{} }
 func (*ImageStatus) ProtoMessage() {}
 func (*ImageStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{8}
+	return fileDescriptor_51c65cb1807988f9, []int{13}
 }
 func (m *ImageStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,16 +907,20 @@ func (m *ImageStatus) GetUpdatedAt() time.Time {
 }
 
 type ImageRemoveRequest struct {
-	// Spec of the image to remove.
-	Image                *v1alpha2.ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	// Spec of the image to remove, deprecated in favor of images.
+	Image *v1alpha2.ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// Specs of the images to remove.
+	Images []*v1alpha2.ImageSpec `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
+	// Remove images referenced by several repositories, and untag those in use by a container.
+	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ImageRemoveRequest) Reset()      { *m = ImageRemoveRequest{} }
 func (*ImageRemoveRequest) ProtoMessage() {}
 func (*ImageRemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{9}
+	return fileDescriptor_51c65cb1807988f9, []int{14}
 }
 func (m *ImageRemoveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,15 +956,31 @@ func (m *ImageRemoveRequest) GetImage() *v1alpha2.ImageSpec {
 	return nil
 }
 
+func (m *ImageRemoveRequest) GetImages() []*v1alpha2.ImageSpec {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *ImageRemoveRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type ImageRemoveResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Results, in order of the requested images.
+	Results              []*ImageRemoveResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *ImageRemoveResponse) Reset()      { *m = ImageRemoveResponse{} }
 func (*ImageRemoveResponse) ProtoMessage() {}
 func (*ImageRemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{10}
+	return fileDescriptor_51c65cb1807988f9, []int{15}
 }
 func (m *ImageRemoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,6 +1009,276 @@ func (m *ImageRemoveResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ImageRemoveResponse proto.InternalMessageInfo
 
+func (m *ImageRemoveResponse) GetResults() []*ImageRemoveResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ImageRemoveResult struct {
+	Image *v1alpha2.ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// References that were removed.
+	Untagged []string `protobuf:"bytes,2,rep,name=untagged,proto3" json:"untagged,omitempty"`
+	// Id of the image, if it was deleted.
+	Deleted string `protobuf:"bytes,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// The gRPC status code and message of the error removing the image, if any.
+	Code                 int32    `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImageRemoveResult) Reset()      { *m = ImageRemoveResult{} }
+func (*ImageRemoveResult) ProtoMessage() {}
+func (*ImageRemoveResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{16}
+}
+func (m *ImageRemoveResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageRemoveResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageRemoveResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImageRemoveResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageRemoveResult.Merge(m, src)
+}
+func (m *ImageRemoveResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageRemoveResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageRemoveResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageRemoveResult proto.InternalMessageInfo
+
+func (m *ImageRemoveResult) GetImage() *v1alpha2.ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *ImageRemoveResult) GetUntagged() []string {
+	if m != nil {
+		return m.Untagged
+	}
+	return nil
+}
+
+func (m *ImageRemoveResult) GetDeleted() string {
+	if m != nil {
+		return m.Deleted
+	}
+	return ""
+}
+
+func (m *ImageRemoveResult) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ImageRemoveResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImagePruneRequest struct {
+	// Remove all images not used by a container, not only dangling ones.
+	All bool `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
+	// Docker-style filters, only until and label are supported.
+	Filters []string `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	// Report what would be removed without removing anything.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImagePruneRequest) Reset()      { *m = ImagePruneRequest{} }
+func (*ImagePruneRequest) ProtoMessage() {}
+func (*ImagePruneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{17}
+}
+func (m *ImagePruneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImagePruneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImagePruneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImagePruneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImagePruneRequest.Merge(m, src)
+}
+func (m *ImagePruneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImagePruneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImagePruneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImagePruneRequest proto.InternalMessageInfo
+
+func (m *ImagePruneRequest) GetAll() bool {
+	if m != nil {
+		return m.All
+	}
+	return false
+}
+
+func (m *ImagePruneRequest) GetFilters() []string {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *ImagePruneRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ImagePruneResponse struct {
+	// Images removed, or that would be removed for a dry-run.
+	Images []*ImagePruned `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	// Bytes of content reclaimed, or that would be reclaimed for a dry-run.
+	SpaceReclaimed       int64    `protobuf:"varint,2,opt,name=space_reclaimed,json=spaceReclaimed,proto3" json:"space_reclaimed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImagePruneResponse) Reset()      { *m = ImagePruneResponse{} }
+func (*ImagePruneResponse) ProtoMessage() {}
+func (*ImagePruneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{18}
+}
+func (m *ImagePruneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImagePruneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImagePruneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImagePruneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImagePruneResponse.Merge(m, src)
+}
+func (m *ImagePruneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImagePruneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImagePruneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImagePruneResponse proto.InternalMessageInfo
+
+func (m *ImagePruneResponse) GetImages() []*ImagePruned {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *ImagePruneResponse) GetSpaceReclaimed() int64 {
+	if m != nil {
+		return m.SpaceReclaimed
+	}
+	return 0
+}
+
+type ImagePruned struct {
+	// Containerd namespace the image was removed from.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Id of the image.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// References to the image that were removed.
+	Refs                 []string `protobuf:"bytes,3,rep,name=refs,proto3" json:"refs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImagePruned) Reset()      { *m = ImagePruned{} }
+func (*ImagePruned) ProtoMessage() {}
+func (*ImagePruned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{19}
+}
+func (m *ImagePruned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImagePruned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImagePruned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImagePruned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImagePruned.Merge(m, src)
+}
+func (m *ImagePruned) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImagePruned) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImagePruned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImagePruned proto.InternalMessageInfo
+
+func (m *ImagePruned) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ImagePruned) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ImagePruned) GetRefs() []string {
+	if m != nil {
+		return m.Refs
+	}
+	return nil
+}
+
 type ImageStatusRequest struct {
 	// Spec of the image.
 	Image                *v1alpha2.ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
@@ -591,7 +1289,7 @@ type ImageStatusRequest struct {
 func (m *ImageStatusRequest) Reset()      { *m = ImageStatusRequest{} }
 func (*ImageStatusRequest) ProtoMessage() {}
 func (*ImageStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{11}
+	return fileDescriptor_51c65cb1807988f9, []int{20}
 }
 func (m *ImageStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,7 +1335,7 @@ type ImageStatusResponse struct {
 func (m *ImageStatusResponse) Reset()      { *m = ImageStatusResponse{} }
 func (*ImageStatusResponse) ProtoMessage() {}
 func (*ImageStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{12}
+	return fileDescriptor_51c65cb1807988f9, []int{21}
 }
 func (m *ImageStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,25 +1371,26 @@ func (m *ImageStatusResponse) GetImage() *v1alpha2.Image {
 	return nil
 }
 
-type ImageTagRequest struct {
-	// Spec of the image to remove.
-	Image                *v1alpha2.ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Tags                 []string            `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type ImageInspectRequest struct {
+	// Spec of the image.
+	Image *v1alpha2.ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// Platform of the manifest to inspect, defaults to that of the agent.
+	Platform             string   `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageTagRequest) Reset()      { *m = ImageTagRequest{} }
-func (*ImageTagRequest) ProtoMessage() {}
-func (*ImageTagRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{13}
+func (m *ImageInspectRequest) Reset()      { *m = ImageInspectRequest{} }
+func (*ImageInspectRequest) ProtoMessage() {}
+func (*ImageInspectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{22}
 }
-func (m *ImageTagRequest) XXX_Unmarshal(b []byte) error {
+func (m *ImageInspectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ImageTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ImageInspectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ImageTagRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ImageInspectRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -701,50 +1400,64 @@ func (m *ImageTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ImageTagRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImageTagRequest.Merge(m, src)
+func (m *ImageInspectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageInspectRequest.Merge(m, src)
 }
-func (m *ImageTagRequest) XXX_Size() int {
+func (m *ImageInspectRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ImageTagRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImageTagRequest.DiscardUnknown(m)
+func (m *ImageInspectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageInspectRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImageTagRequest proto.InternalMessageInfo
+var xxx_messageInfo_ImageInspectRequest proto.InternalMessageInfo
 
-func (m *ImageTagRequest) GetImage() *v1alpha2.ImageSpec {
+func (m *ImageInspectRequest) GetImage() *v1alpha2.ImageSpec {
 	if m != nil {
 		return m.Image
 	}
 	return nil
 }
 
-func (m *ImageTagRequest) GetTags() []string {
+func (m *ImageInspectRequest) GetPlatform() string {
 	if m != nil {
-		return m.Tags
+		return m.Platform
 	}
-	return nil
+	return ""
 }
 
-type ImageTagResponse struct {
+type ImageInspectResponse struct {
 	// Status of the image.
-	Image                *v1alpha2.Image `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Image *v1alpha2.Image `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// Name of the image in the image store.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Media type of the image target.
+	MediaType string `protobuf:"bytes,3,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	// Digest of the image target.
+	Digest string `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Raw index, present only when the image target is an index (aka manifest list).
+	Index []byte `protobuf:"bytes,5,opt,name=index,proto3" json:"index,omitempty"`
+	// Raw manifest, selected by platform when the image target is an index.
+	Manifest []byte `protobuf:"bytes,6,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Raw config referenced by the manifest.
+	Config []byte `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
+	// Platforms supported by the image.
+	Platforms            []string `protobuf:"bytes,8,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageTagResponse) Reset()      { *m = ImageTagResponse{} }
-func (*ImageTagResponse) ProtoMessage() {}
-func (*ImageTagResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c65cb1807988f9, []int{14}
+func (m *ImageInspectResponse) Reset()      { *m = ImageInspectResponse{} }
+func (*ImageInspectResponse) ProtoMessage() {}
+func (*ImageInspectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{23}
 }
-func (m *ImageTagResponse) XXX_Unmarshal(b []byte) error {
+func (m *ImageInspectResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ImageTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ImageInspectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ImageTagResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ImageInspectResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -754,1505 +1467,11371 @@ func (m *ImageTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ImageTagResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImageTagResponse.Merge(m, src)
+func (m *ImageInspectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageInspectResponse.Merge(m, src)
 }
-func (m *ImageTagResponse) XXX_Size() int {
+func (m *ImageInspectResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ImageTagResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImageTagResponse.DiscardUnknown(m)
+func (m *ImageInspectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageInspectResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImageTagResponse proto.InternalMessageInfo
+var xxx_messageInfo_ImageInspectResponse proto.InternalMessageInfo
 
-func (m *ImageTagResponse) GetImage() *v1alpha2.Image {
+func (m *ImageInspectResponse) GetImage() *v1alpha2.Image {
 	if m != nil {
 		return m.Image
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ImageListRequest)(nil), "kim.services.images.v1alpha1.ImageListRequest")
-	proto.RegisterType((*ImageListResponse)(nil), "kim.services.images.v1alpha1.ImageListResponse")
-	proto.RegisterType((*ImagePullRequest)(nil), "kim.services.images.v1alpha1.ImagePullRequest")
-	proto.RegisterType((*ImagePullResponse)(nil), "kim.services.images.v1alpha1.ImagePullResponse")
-	proto.RegisterType((*ImagePushRequest)(nil), "kim.services.images.v1alpha1.ImagePushRequest")
-	proto.RegisterType((*ImagePushResponse)(nil), "kim.services.images.v1alpha1.ImagePushResponse")
-	proto.RegisterType((*ImageProgressRequest)(nil), "kim.services.images.v1alpha1.ImageProgressRequest")
-	proto.RegisterType((*ImageProgressResponse)(nil), "kim.services.images.v1alpha1.ImageProgressResponse")
-	proto.RegisterType((*ImageStatus)(nil), "kim.services.images.v1alpha1.ImageStatus")
-	proto.RegisterType((*ImageRemoveRequest)(nil), "kim.services.images.v1alpha1.ImageRemoveRequest")
-	proto.RegisterType((*ImageRemoveResponse)(nil), "kim.services.images.v1alpha1.ImageRemoveResponse")
-	proto.RegisterType((*ImageStatusRequest)(nil), "kim.services.images.v1alpha1.ImageStatusRequest")
-	proto.RegisterType((*ImageStatusResponse)(nil), "kim.services.images.v1alpha1.ImageStatusResponse")
-	proto.RegisterType((*ImageTagRequest)(nil), "kim.services.images.v1alpha1.ImageTagRequest")
-	proto.RegisterType((*ImageTagResponse)(nil), "kim.services.images.v1alpha1.ImageTagResponse")
+func (m *ImageInspectResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("pkg/apis/services/images/v1alpha1/images.proto", fileDescriptor_51c65cb1807988f9)
+func (m *ImageInspectResponse) GetMediaType() string {
+	if m != nil {
+		return m.MediaType
+	}
+	return ""
 }
 
-var fileDescriptor_51c65cb1807988f9 = []byte{
-	// 718 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0xb2, 0x4b, 0xf3, 0x67, 0xf8, 0x27, 0xe2, 0x08, 0xba, 0x59, 0xb1, 0x90, 0x9e, 0x96,
-	0xc4, 0x9d, 0xb2, 0x25, 0x26, 0x26, 0x9e, 0x16, 0x88, 0x04, 0xe3, 0xc1, 0x54, 0x0e, 0xc6, 0x8b,
-	0x0e, 0xcb, 0x6c, 0xdb, 0xb0, 0x65, 0x6a, 0x67, 0x8a, 0xf1, 0xe6, 0x47, 0xe0, 0xe8, 0x47, 0xe2,
-	0xe8, 0xd1, 0x93, 0xca, 0xf2, 0x01, 0xfc, 0x04, 0x26, 0x66, 0x66, 0x5e, 0x97, 0xae, 0x0a, 0x16,
-	0x38, 0x70, 0xeb, 0x9b, 0xf9, 0xfd, 0x7e, 0xef, 0xf7, 0x76, 0xde, 0x7b, 0x59, 0x44, 0xd2, 0xfd,
-	0xd0, 0xa3, 0x69, 0x2c, 0x3c, 0xc1, 0xb2, 0xc3, 0xb8, 0xcf, 0x84, 0x17, 0x27, 0x34, 0x64, 0xc2,
-	0x3b, 0xec, 0xd2, 0x61, 0x1a, 0xd1, 0x2e, 0xc4, 0x24, 0xcd, 0xb8, 0xe4, 0x78, 0x71, 0x3f, 0x4e,
-	0x48, 0x01, 0x25, 0x70, 0x55, 0x40, 0x5b, 0x4b, 0x21, 0xe7, 0xe1, 0x90, 0x79, 0x1a, 0xbb, 0x9b,
-	0x0f, 0x3c, 0x19, 0x27, 0x4c, 0x48, 0x9a, 0xa4, 0x86, 0xde, 0xea, 0x84, 0xb1, 0x8c, 0xf2, 0x5d,
-	0xd2, 0xe7, 0x89, 0x17, 0xf2, 0x90, 0x9f, 0x21, 0x55, 0xa4, 0x03, 0xfd, 0x05, 0x70, 0x7f, 0xff,
-	0xb1, 0x20, 0x31, 0xf7, 0xfa, 0x59, 0xdc, 0xa1, 0x69, 0xec, 0x8d, 0xcd, 0x66, 0xf9, 0x81, 0x92,
-	0x2e, 0x4c, 0xfa, 0xea, 0xd4, 0x70, 0xdc, 0x6d, 0x34, 0xb7, 0xad, 0x6c, 0x3d, 0x8f, 0x85, 0x0c,
-	0xd8, 0xbb, 0x9c, 0x09, 0x89, 0x1f, 0x21, 0x7b, 0x10, 0x0f, 0x25, 0xcb, 0x9a, 0xd6, 0xb2, 0xd5,
-	0x9e, 0xf5, 0x1f, 0x10, 0x10, 0x28, 0xac, 0xfb, 0x44, 0x73, 0x9e, 0x6a, 0x50, 0x00, 0x60, 0x77,
-	0x13, 0xdd, 0x2e, 0x49, 0x89, 0x94, 0x1f, 0x08, 0x86, 0x3d, 0x64, 0x9b, 0xb2, 0x9b, 0xd6, 0x72,
-	0xbd, 0x3d, 0xeb, 0xdf, 0x3b, 0x47, 0x2b, 0x00, 0x98, 0xfb, 0x1e, 0x0c, 0xbd, 0xc8, 0x87, 0xc3,
-	0xc2, 0x50, 0x17, 0x4d, 0xeb, 0x5b, 0xf0, 0x73, 0xff, 0x1c, 0x8d, 0x97, 0x29, 0xeb, 0x07, 0x06,
-	0x89, 0x57, 0x51, 0x83, 0xe6, 0x32, 0x6a, 0x4e, 0x69, 0xc6, 0xe2, 0x9f, 0x8c, 0x5e, 0x2e, 0xa3,
-	0x0d, 0x7e, 0x30, 0x88, 0xc3, 0x40, 0x23, 0xdd, 0x15, 0xb0, 0x6f, 0x12, 0x83, 0xfd, 0xf9, 0x72,
-	0xe6, 0x19, 0x10, 0x2f, 0x79, 0x14, 0xd1, 0x0d, 0x79, 0x54, 0x89, 0x2f, 0xf4, 0xf8, 0x10, 0xcd,
-	0x1b, 0x68, 0xc6, 0xc3, 0x8c, 0x09, 0x51, 0xf8, 0xfc, 0x3b, 0xfa, 0x2d, 0x5a, 0xf8, 0x0d, 0x0d,
-	0xe2, 0x5b, 0xc8, 0x16, 0x92, 0xca, 0xbc, 0x78, 0xbf, 0x15, 0x72, 0x51, 0x4b, 0x43, 0x8d, 0x9a,
-	0xb0, 0xde, 0x38, 0xfe, 0xba, 0x54, 0x0b, 0x80, 0xee, 0xfe, 0xb0, 0xd0, 0x6c, 0xe9, 0x16, 0xcf,
-	0xa1, 0x7a, 0xc6, 0x06, 0xe0, 0x42, 0x7d, 0xe2, 0xbb, 0xe3, 0x54, 0x53, 0xfa, 0x10, 0x22, 0x75,
-	0xce, 0x07, 0x03, 0xc1, 0x64, 0xb3, 0xbe, 0x6c, 0xb5, 0xeb, 0x01, 0x44, 0xaa, 0x12, 0xc9, 0x25,
-	0x1d, 0x36, 0x1b, 0xfa, 0xd8, 0x04, 0x78, 0x03, 0x21, 0x21, 0x69, 0x26, 0xd9, 0xde, 0x1b, 0x2a,
-	0x9b, 0xd3, 0xfa, 0xa7, 0x6d, 0x11, 0x33, 0x69, 0xa4, 0x98, 0x1f, 0xb2, 0x53, 0x4c, 0xda, 0xfa,
-	0x7f, 0xca, 0xe5, 0xd1, 0xb7, 0x25, 0x2b, 0x98, 0x01, 0x5e, 0x4f, 0x2a, 0x91, 0x3c, 0xdd, 0xa3,
-	0x20, 0x62, 0x5f, 0x46, 0x04, 0x78, 0x3d, 0xe9, 0x6e, 0x21, 0x6c, 0x5a, 0x9b, 0x25, 0xfc, 0x90,
-	0x5d, 0xbd, 0x4f, 0xdc, 0x05, 0x74, 0x67, 0x42, 0xc8, 0x3c, 0xcd, 0x58, 0xdf, 0xfc, 0xa0, 0xd7,
-	0xd0, 0xdf, 0x04, 0xfd, 0x42, 0x08, 0x9e, 0xbe, 0x33, 0xa9, 0x74, 0xee, 0xe4, 0x82, 0xca, 0x2b,
-	0x74, 0x4b, 0xc7, 0x3b, 0x34, 0xbc, 0xc6, 0x4c, 0x60, 0xd4, 0x90, 0x34, 0x54, 0x2d, 0x50, 0x6f,
-	0xcf, 0x04, 0xfa, 0xdb, 0xed, 0xc1, 0xb8, 0x69, 0xe5, 0x2b, 0x99, 0xf3, 0x7f, 0xda, 0xc8, 0xd6,
-	0x07, 0x02, 0x27, 0xc8, 0x86, 0x16, 0x5c, 0xad, 0xdc, 0xcb, 0x50, 0x50, 0xab, 0x7b, 0x09, 0x06,
-	0x18, 0x0d, 0x51, 0x43, 0x2d, 0x44, 0x4c, 0x2a, 0x50, 0x4b, 0x4b, 0xb8, 0xe5, 0x55, 0xc6, 0x9f,
-	0x25, 0x52, 0xab, 0xab, 0x52, 0xa2, 0xd2, 0x72, 0xad, 0x94, 0x68, 0x62, 0x27, 0x7e, 0x40, 0xff,
-	0xab, 0xb8, 0x58, 0x15, 0xd8, 0xaf, 0x22, 0x30, 0xb9, 0x85, 0x5a, 0x6b, 0x97, 0xe2, 0x98, 0xc4,
-	0xab, 0x96, 0xa9, 0x51, 0x44, 0x15, 0x6b, 0x1c, 0x2f, 0xe7, 0x8a, 0x35, 0x96, 0x76, 0xaa, 0xae,
-	0x51, 0x44, 0x37, 0x51, 0x63, 0x82, 0x6c, 0x33, 0xe8, 0x95, 0xfa, 0x73, 0x62, 0xb9, 0x54, 0xea,
-	0xcf, 0xc9, 0x2d, 0x82, 0xf7, 0x50, 0x7d, 0x87, 0x86, 0xb8, 0x53, 0x81, 0x79, 0x36, 0xd9, 0x2d,
-	0x52, 0x15, 0x6e, 0xb2, 0xac, 0x3f, 0x3b, 0x3e, 0x71, 0xac, 0x2f, 0x27, 0x4e, 0xed, 0xe3, 0xc8,
-	0xb1, 0x8e, 0x47, 0x8e, 0xf5, 0x79, 0xe4, 0x58, 0xdf, 0x47, 0x8e, 0x75, 0x74, 0xea, 0xd4, 0x3e,
-	0x9d, 0x3a, 0xb5, 0xd7, 0xed, 0x7f, 0xfe, 0xb5, 0x7a, 0x62, 0xe2, 0x5d, 0x5b, 0x2f, 0xe0, 0xb5,
-	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x5a, 0xa7, 0xa7, 0x8d, 0x09, 0x00, 0x00,
+func (m *ImageInspectResponse) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ImageInspectResponse) GetIndex() []byte {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ImageInspectResponse) GetManifest() []byte {
+	if m != nil {
+		return m.Manifest
+	}
+	return nil
+}
 
-// ImagesClient is the client API for Images service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ImagesClient interface {
-	// Status of an image
-	Status(ctx context.Context, in *ImageStatusRequest, opts ...grpc.CallOption) (*ImageStatusResponse, error)
-	// List images
-	List(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error)
-	// Pull an image
-	Pull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (*ImagePullResponse, error)
-	PullProgress(ctx context.Context, in *ImageProgressRequest, opts ...grpc.CallOption) (Images_PullProgressClient, error)
-	// Push an image
-	Push(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (*ImagePushResponse, error)
-	PushProgress(ctx context.Context, in *ImageProgressRequest, opts ...grpc.CallOption) (Images_PushProgressClient, error)
-	// Remove an image
-	Remove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error)
-	// Tag an image
-	Tag(ctx context.Context, in *ImageTagRequest, opts ...grpc.CallOption) (*ImageTagResponse, error)
+func (m *ImageInspectResponse) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
 }
 
-type imagesClient struct {
-	cc *grpc.ClientConn
+func (m *ImageInspectResponse) GetPlatforms() []string {
+	if m != nil {
+		return m.Platforms
+	}
+	return nil
 }
 
-func NewImagesClient(cc *grpc.ClientConn) ImagesClient {
-	return &imagesClient{cc}
+type ImageHistoryRequest struct {
+	// Spec of the image.
+	Image *v1alpha2.ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// Platform of the manifest to inspect, defaults to that of the agent.
+	Platform             string   `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *imagesClient) Status(ctx context.Context, in *ImageStatusRequest, opts ...grpc.CallOption) (*ImageStatusResponse, error) {
-	out := new(ImageStatusResponse)
-	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Status", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImageHistoryRequest) Reset()      { *m = ImageHistoryRequest{} }
+func (*ImageHistoryRequest) ProtoMessage() {}
+func (*ImageHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{24}
+}
+func (m *ImageHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ImageHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageHistoryRequest.Merge(m, src)
+}
+func (m *ImageHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *imagesClient) List(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error) {
-	out := new(ImageListResponse)
-	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImageHistoryRequest proto.InternalMessageInfo
+
+func (m *ImageHistoryRequest) GetImage() *v1alpha2.ImageSpec {
+	if m != nil {
+		return m.Image
 	}
-	return out, nil
+	return nil
 }
 
-func (c *imagesClient) Pull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (*ImagePullResponse, error) {
-	out := new(ImagePullResponse)
-	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Pull", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImageHistoryRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
 	}
-	return out, nil
+	return ""
 }
 
-func (c *imagesClient) PullProgress(ctx context.Context, in *ImageProgressRequest, opts ...grpc.CallOption) (Images_PullProgressClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Images_serviceDesc.Streams[0], "/kim.services.images.v1alpha1.Images/PullProgress", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &imagesPullProgressClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+type ImageHistoryResponse struct {
+	// Id of the image.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// History of the image, most recent first.
+	History              []ImageHistory `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImageHistoryResponse) Reset()      { *m = ImageHistoryResponse{} }
+func (*ImageHistoryResponse) ProtoMessage() {}
+func (*ImageHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{25}
+}
+func (m *ImageHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+}
+func (m *ImageHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageHistoryResponse.Merge(m, src)
+}
+func (m *ImageHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageHistoryResponse proto.InternalMessageInfo
+
+func (m *ImageHistoryResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return x, nil
+	return ""
 }
 
-type Images_PullProgressClient interface {
-	Recv() (*ImageProgressResponse, error)
-	grpc.ClientStream
+func (m *ImageHistoryResponse) GetHistory() []ImageHistory {
+	if m != nil {
+		return m.History
+	}
+	return nil
 }
 
-type imagesPullProgressClient struct {
-	grpc.ClientStream
+// joins an entry of the image config history with the manifest layer it produced, if any
+type ImageHistory struct {
+	Created    time.Time `protobuf:"bytes,1,opt,name=created,proto3,stdtime" json:"created"`
+	CreatedBy  string    `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Author     string    `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Comment    string    `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	EmptyLayer bool      `protobuf:"varint,5,opt,name=empty_layer,json=emptyLayer,proto3" json:"empty_layer,omitempty"`
+	// Digest of the layer, empty for empty layers.
+	Layer string `protobuf:"bytes,6,opt,name=layer,proto3" json:"layer,omitempty"`
+	// Size of the (compressed) layer.
+	Size_                int64    `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *imagesPullProgressClient) Recv() (*ImageProgressResponse, error) {
-	m := new(ImageProgressResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ImageHistory) Reset()      { *m = ImageHistory{} }
+func (*ImageHistory) ProtoMessage() {}
+func (*ImageHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{26}
+}
+func (m *ImageHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *ImageHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageHistory.Merge(m, src)
+}
+func (m *ImageHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageHistory.DiscardUnknown(m)
 }
 
-func (c *imagesClient) Push(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (*ImagePushResponse, error) {
-	out := new(ImagePushResponse)
-	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Push", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImageHistory proto.InternalMessageInfo
+
+func (m *ImageHistory) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *imagesClient) PushProgress(ctx context.Context, in *ImageProgressRequest, opts ...grpc.CallOption) (Images_PushProgressClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Images_serviceDesc.Streams[1], "/kim.services.images.v1alpha1.Images/PushProgress", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &imagesPushProgressClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ImageHistory) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
 	}
-	return x, nil
+	return ""
 }
 
-type Images_PushProgressClient interface {
-	Recv() (*ImageProgressResponse, error)
-	grpc.ClientStream
+func (m *ImageHistory) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
 }
 
-type imagesPushProgressClient struct {
-	grpc.ClientStream
+func (m *ImageHistory) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
 }
 
-func (x *imagesPushProgressClient) Recv() (*ImageProgressResponse, error) {
-	m := new(ImageProgressResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ImageHistory) GetEmptyLayer() bool {
+	if m != nil {
+		return m.EmptyLayer
 	}
-	return m, nil
+	return false
 }
 
-func (c *imagesClient) Remove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error) {
-	out := new(ImageRemoveResponse)
-	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Remove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImageHistory) GetLayer() string {
+	if m != nil {
+		return m.Layer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *imagesClient) Tag(ctx context.Context, in *ImageTagRequest, opts ...grpc.CallOption) (*ImageTagResponse, error) {
-	out := new(ImageTagResponse)
-	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Tag", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImageHistory) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
 	}
-	return out, nil
+	return 0
 }
 
-// ImagesServer is the server API for Images service.
-type ImagesServer interface {
-	// Status of an image
-	Status(context.Context, *ImageStatusRequest) (*ImageStatusResponse, error)
-	// List images
-	List(context.Context, *ImageListRequest) (*ImageListResponse, error)
-	// Pull an image
-	Pull(context.Context, *ImagePullRequest) (*ImagePullResponse, error)
-	PullProgress(*ImageProgressRequest, Images_PullProgressServer) error
-	// Push an image
-	Push(context.Context, *ImagePushRequest) (*ImagePushResponse, error)
-	PushProgress(*ImageProgressRequest, Images_PushProgressServer) error
-	// Remove an image
-	Remove(context.Context, *ImageRemoveRequest) (*ImageRemoveResponse, error)
-	// Tag an image
-	Tag(context.Context, *ImageTagRequest) (*ImageTagResponse, error)
+type InfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedImagesServer can be embedded to have forward compatible implementations.
-type UnimplementedImagesServer struct {
+func (m *InfoRequest) Reset()      { *m = InfoRequest{} }
+func (*InfoRequest) ProtoMessage() {}
+func (*InfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{27}
 }
-
-func (*UnimplementedImagesServer) Status(ctx context.Context, req *ImageStatusRequest) (*ImageStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+func (m *InfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedImagesServer) List(ctx context.Context, req *ImageListRequest) (*ImageListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+func (m *InfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedImagesServer) Pull(ctx context.Context, req *ImagePullRequest) (*ImagePullResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pull not implemented")
+func (m *InfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfoRequest.Merge(m, src)
 }
-func (*UnimplementedImagesServer) PullProgress(req *ImageProgressRequest, srv Images_PullProgressServer) error {
-	return status.Errorf(codes.Unimplemented, "method PullProgress not implemented")
+func (m *InfoRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedImagesServer) Push(ctx context.Context, req *ImagePushRequest) (*ImagePushResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Push not implemented")
+func (m *InfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfoRequest proto.InternalMessageInfo
+
+type InfoResponse struct {
+	// Version of the agent.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Git commit of the agent.
+	GitCommit string `protobuf:"bytes,2,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	// Platform of the agent, os/arch.
+	Platform string `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	// Address of the containerd socket.
+	ContainerdSocket string `protobuf:"bytes,4,opt,name=containerd_socket,json=containerdSocket,proto3" json:"containerd_socket,omitempty"`
+	// Version of containerd.
+	ContainerdVersion string `protobuf:"bytes,5,opt,name=containerd_version,json=containerdVersion,proto3" json:"containerd_version,omitempty"`
+	// Address of the buildkitd socket.
+	BuildkitSocket string `protobuf:"bytes,6,opt,name=buildkit_socket,json=buildkitSocket,proto3" json:"buildkit_socket,omitempty"`
+	// Usage of the volumes holding the buildkit and containerd state.
+	Volumes              []*VolumeUsage `protobuf:"bytes,7,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InfoResponse) Reset()      { *m = InfoResponse{} }
+func (*InfoResponse) ProtoMessage() {}
+func (*InfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{28}
+}
+func (m *InfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedImagesServer) PushProgress(req *ImageProgressRequest, srv Images_PushProgressServer) error {
-	return status.Errorf(codes.Unimplemented, "method PushProgress not implemented")
+func (m *InfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedImagesServer) Remove(ctx context.Context, req *ImageRemoveRequest) (*ImageRemoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+func (m *InfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfoResponse.Merge(m, src)
 }
-func (*UnimplementedImagesServer) Tag(ctx context.Context, req *ImageTagRequest) (*ImageTagResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tag not implemented")
+func (m *InfoResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterImagesServer(s *grpc.Server, srv ImagesServer) {
-	s.RegisterService(&_Images_serviceDesc, srv)
+func (m *InfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfoResponse.DiscardUnknown(m)
 }
 
-func _Images_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ImagesServer).Status(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kim.services.images.v1alpha1.Images/Status",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImagesServer).Status(ctx, req.(*ImageStatusRequest))
+var xxx_messageInfo_InfoResponse proto.InternalMessageInfo
+
+func (m *InfoResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Images_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ImagesServer).List(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kim.services.images.v1alpha1.Images/List",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImagesServer).List(ctx, req.(*ImageListRequest))
+func (m *InfoResponse) GetGitCommit() string {
+	if m != nil {
+		return m.GitCommit
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Images_Pull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImagePullRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ImagesServer).Pull(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kim.services.images.v1alpha1.Images/Pull",
+func (m *InfoResponse) GetPlatform() string {
+	if m != nil {
+		return m.Platform
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImagesServer).Pull(ctx, req.(*ImagePullRequest))
+	return ""
+}
+
+func (m *InfoResponse) GetContainerdSocket() string {
+	if m != nil {
+		return m.ContainerdSocket
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Images_PullProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ImageProgressRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *InfoResponse) GetContainerdVersion() string {
+	if m != nil {
+		return m.ContainerdVersion
 	}
-	return srv.(ImagesServer).PullProgress(m, &imagesPullProgressServer{stream})
+	return ""
 }
 
-type Images_PullProgressServer interface {
-	Send(*ImageProgressResponse) error
-	grpc.ServerStream
+func (m *InfoResponse) GetBuildkitSocket() string {
+	if m != nil {
+		return m.BuildkitSocket
+	}
+	return ""
 }
 
-type imagesPullProgressServer struct {
-	grpc.ServerStream
+func (m *InfoResponse) GetVolumes() []*VolumeUsage {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
 }
 
-func (x *imagesPullProgressServer) Send(m *ImageProgressResponse) error {
-	return x.ServerStream.SendMsg(m)
+type VolumeUsage struct {
+	// Name of the volume, buildkit or containerd.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Path of the volume on the node.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Total bytes of the filesystem of the volume.
+	Total uint64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// Bytes used on the filesystem of the volume.
+	Used uint64 `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+	// Bytes available on the filesystem of the volume.
+	Available uint64 `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	// Error determining the usage, if any.
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _Images_Push_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImagePushRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *VolumeUsage) Reset()      { *m = VolumeUsage{} }
+func (*VolumeUsage) ProtoMessage() {}
+func (*VolumeUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{29}
+}
+func (m *VolumeUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(ImagesServer).Push(ctx, in)
+}
+func (m *VolumeUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeUsage.Merge(m, src)
+}
+func (m *VolumeUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeUsage proto.InternalMessageInfo
+
+func (m *VolumeUsage) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kim.services.images.v1alpha1.Images/Push",
+	return ""
+}
+
+func (m *VolumeUsage) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImagesServer).Push(ctx, req.(*ImagePushRequest))
+	return ""
+}
+
+func (m *VolumeUsage) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Images_PushProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ImageProgressRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *VolumeUsage) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
 	}
-	return srv.(ImagesServer).PushProgress(m, &imagesPushProgressServer{stream})
+	return 0
 }
 
-type Images_PushProgressServer interface {
-	Send(*ImageProgressResponse) error
-	grpc.ServerStream
+func (m *VolumeUsage) GetAvailable() uint64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
 }
 
-type imagesPushProgressServer struct {
-	grpc.ServerStream
+func (m *VolumeUsage) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (x *imagesPushProgressServer) Send(m *ImageProgressResponse) error {
-	return x.ServerStream.SendMsg(m)
+type ImageTagRequest struct {
+	// Spec of the image to remove.
+	Image                *v1alpha2.ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Tags                 []string            `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func _Images_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageRemoveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ImagesServer).Remove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kim.services.images.v1alpha1.Images/Remove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImagesServer).Remove(ctx, req.(*ImageRemoveRequest))
+func (m *ImageTagRequest) Reset()      { *m = ImageTagRequest{} }
+func (*ImageTagRequest) ProtoMessage() {}
+func (*ImageTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{30}
+}
+func (m *ImageTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ImageTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageTagRequest.Merge(m, src)
+}
+func (m *ImageTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageTagRequest.DiscardUnknown(m)
 }
 
-func _Images_Tag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageTagRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ImagesServer).Tag(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kim.services.images.v1alpha1.Images/Tag",
+var xxx_messageInfo_ImageTagRequest proto.InternalMessageInfo
+
+func (m *ImageTagRequest) GetImage() *v1alpha2.ImageSpec {
+	if m != nil {
+		return m.Image
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImagesServer).Tag(ctx, req.(*ImageTagRequest))
+	return nil
+}
+
+func (m *ImageTagRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Images_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kim.services.images.v1alpha1.Images",
-	HandlerType: (*ImagesServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Status",
-			Handler:    _Images_Status_Handler,
-		},
-		{
-			MethodName: "List",
-			Handler:    _Images_List_Handler,
-		},
-		{
-			MethodName: "Pull",
-			Handler:    _Images_Pull_Handler,
-		},
-		{
-			MethodName: "Push",
-			Handler:    _Images_Push_Handler,
-		},
-		{
-			MethodName: "Remove",
-			Handler:    _Images_Remove_Handler,
-		},
-		{
-			MethodName: "Tag",
-			Handler:    _Images_Tag_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "PullProgress",
-			Handler:       _Images_PullProgress_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "PushProgress",
-			Handler:       _Images_PushProgress_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pkg/apis/services/images/v1alpha1/images.proto",
+type ImageTagResponse struct {
+	// Status of the image.
+	Image                *v1alpha2.Image `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ImageListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ImageTagResponse) Reset()      { *m = ImageTagResponse{} }
+func (*ImageTagResponse) ProtoMessage() {}
+func (*ImageTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{31}
 }
-
-func (m *ImageListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ImageTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ImageListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Filter != nil {
-		{
-			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
+func (m *ImageTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ImageTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageTagResponse.Merge(m, src)
+}
+func (m *ImageTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageTagResponse.DiscardUnknown(m)
 }
 
-func (m *ImageListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImageTagResponse proto.InternalMessageInfo
+
+func (m *ImageTagResponse) GetImage() *v1alpha2.Image {
+	if m != nil {
+		return m.Image
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ImageListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ImageExportRequest struct {
+	// Specs of the images to export.
+	Images []*v1alpha2.ImageSpec `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	// Platform to export, defaults to that of the agent.
+	Platform string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	// Export all platforms, ignoring platform.
+	AllPlatforms         bool     `protobuf:"varint,3,opt,name=all_platforms,json=allPlatforms,proto3" json:"all_platforms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Images) > 0 {
-		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintImages(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ImageExportRequest) Reset()      { *m = ImageExportRequest{} }
+func (*ImageExportRequest) ProtoMessage() {}
+func (*ImageExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{32}
+}
+func (m *ImageExportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageExportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ImagePullRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ImageExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageExportRequest.Merge(m, src)
 }
-
-func (m *ImagePullRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ImageExportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageExportRequest.DiscardUnknown(m)
 }
 
-func (m *ImagePullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Auth != nil {
-		{
-			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_ImageExportRequest proto.InternalMessageInfo
+
+func (m *ImageExportRequest) GetImages() []*v1alpha2.ImageSpec {
+	if m != nil {
+		return m.Images
 	}
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ImageExportRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ImagePullResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ImageExportRequest) GetAllPlatforms() bool {
+	if m != nil {
+		return m.AllPlatforms
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ImagePullResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ImageExportResponse struct {
+	// Chunk of the exported tarball.
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImagePullResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintImages(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0xa
+func (m *ImageExportResponse) Reset()      { *m = ImageExportResponse{} }
+func (*ImageExportResponse) ProtoMessage() {}
+func (*ImageExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{33}
+}
+func (m *ImageExportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageExportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ImageExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageExportResponse.Merge(m, src)
+}
+func (m *ImageExportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageExportResponse.DiscardUnknown(m)
 }
 
-func (m *ImagePushRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImageExportResponse proto.InternalMessageInfo
+
+func (m *ImageExportResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ImagePushRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ImageImportRequest struct {
+	// Chunk of the tarball to import.
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImagePushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Auth != nil {
-		{
-			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
+func (m *ImageImportRequest) Reset()      { *m = ImageImportRequest{} }
+func (*ImageImportRequest) ProtoMessage() {}
+func (*ImageImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{34}
+}
+func (m *ImageImportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageImportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ImagePushResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ImageImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageImportRequest.Merge(m, src)
 }
-
-func (m *ImagePushResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ImageImportRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *ImagePushResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintImages(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ImageImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageImportRequest.DiscardUnknown(m)
 }
 
-func (m *ImageProgressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImageImportRequest proto.InternalMessageInfo
+
+func (m *ImageImportRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ImageProgressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ImageImportResponse struct {
+	// Names of the imported images.
+	Images               []string `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintImages(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0xa
+func (m *ImageImportResponse) Reset()      { *m = ImageImportResponse{} }
+func (*ImageImportResponse) ProtoMessage() {}
+func (*ImageImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{35}
+}
+func (m *ImageImportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageImportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ImageImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageImportResponse.Merge(m, src)
+}
+func (m *ImageImportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageImportResponse.DiscardUnknown(m)
 }
 
-func (m *ImageProgressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImageImportResponse proto.InternalMessageInfo
+
+func (m *ImageImportResponse) GetImages() []string {
+	if m != nil {
+		return m.Images
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ImageProgressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Job struct {
+	// the request id of the pull or push, or the ref of the build
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// one of build, pull or push
+	Kind  string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Image string `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	// one of running, completed, failed or cancelled
+	State      string     `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Error      string     `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt  time.Time  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	FinishedAt *time.Time `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3,stdtime" json:"finished_at,omitempty"`
+	// the user that started the job, if the agent authenticates its clients
+	Owner                string   `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		for iNdEx := len(m.Status) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Status[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintImages(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *Job) Reset()      { *m = Job{} }
+func (*Job) ProtoMessage() {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{36}
+}
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Job.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
+}
+func (m *Job) XXX_Size() int {
+	return m.Size()
+}
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
 }
 
-func (m *ImageStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Job proto.InternalMessageInfo
+
+func (m *Job) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ImageStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Job) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
 }
 
-func (m *ImageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintImages(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x32
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
-	if err7 != nil {
-		return 0, err7
-	}
-	i -= n7
-	i = encodeVarintImages(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x2a
-	if m.Total != 0 {
-		i = encodeVarintImages(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Offset != 0 {
-		i = encodeVarintImages(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintImages(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
+func (m *Job) GetImage() string {
+	if m != nil {
+		return m.Image
 	}
-	if len(m.Ref) > 0 {
-		i -= len(m.Ref)
-		copy(dAtA[i:], m.Ref)
-		i = encodeVarintImages(dAtA, i, uint64(len(m.Ref)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Job) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ImageRemoveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Job) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ImageRemoveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Job) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
 }
 
-func (m *ImageRemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *Job) GetFinishedAt() *time.Time {
+	if m != nil {
+		return m.FinishedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ImageRemoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Job) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ImageRemoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobListRequest struct {
+	// include recently finished jobs
+	All                  bool     `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageRemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *JobListRequest) Reset()      { *m = JobListRequest{} }
+func (*JobListRequest) ProtoMessage() {}
+func (*JobListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{37}
+}
+func (m *JobListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobListRequest.Merge(m, src)
+}
+func (m *JobListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobListRequest.DiscardUnknown(m)
 }
 
-func (m *ImageStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobListRequest proto.InternalMessageInfo
+
+func (m *JobListRequest) GetAll() bool {
+	if m != nil {
+		return m.All
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ImageStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobListResponse struct {
+	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
+func (m *JobListResponse) Reset()      { *m = JobListResponse{} }
+func (*JobListResponse) ProtoMessage() {}
+func (*JobListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{38}
+}
+func (m *JobListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JobListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobListResponse.Merge(m, src)
+}
+func (m *JobListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobListResponse.DiscardUnknown(m)
 }
 
-func (m *ImageStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobListResponse proto.InternalMessageInfo
+
+func (m *JobListResponse) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ImageStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobGetRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
+func (m *JobGetRequest) Reset()      { *m = JobGetRequest{} }
+func (*JobGetRequest) ProtoMessage() {}
+func (*JobGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{39}
+}
+func (m *JobGetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobGetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JobGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobGetRequest.Merge(m, src)
+}
+func (m *JobGetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobGetRequest.DiscardUnknown(m)
 }
 
-func (m *ImageTagRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobGetRequest proto.InternalMessageInfo
+
+func (m *JobGetRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ImageTagRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobGetResponse struct {
+	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tags) > 0 {
-		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tags[iNdEx])
-			copy(dAtA[i:], m.Tags[iNdEx])
-			i = encodeVarintImages(dAtA, i, uint64(len(m.Tags[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
+func (m *JobGetResponse) Reset()      { *m = JobGetResponse{} }
+func (*JobGetResponse) ProtoMessage() {}
+func (*JobGetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{40}
+}
+func (m *JobGetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobGetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobGetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JobGetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobGetResponse.Merge(m, src)
+}
+func (m *JobGetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobGetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobGetResponse.DiscardUnknown(m)
 }
 
-func (m *ImageTagResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobGetResponse proto.InternalMessageInfo
+
+func (m *JobGetResponse) GetJob() *Job {
+	if m != nil {
+		return m.Job
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ImageTagResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobWatchRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintImages(dAtA, i, uint64(size))
+func (m *JobWatchRequest) Reset()      { *m = JobWatchRequest{} }
+func (*JobWatchRequest) ProtoMessage() {}
+func (*JobWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{41}
+}
+func (m *JobWatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobWatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JobWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobWatchRequest.Merge(m, src)
+}
+func (m *JobWatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobWatchRequest.DiscardUnknown(m)
 }
 
-func encodeVarintImages(dAtA []byte, offset int, v uint64) int {
-	offset -= sovImages(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_JobWatchRequest proto.InternalMessageInfo
+
+func (m *JobWatchRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *ImageListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Filter != nil {
-		l = m.Filter.Size()
-		n += 1 + l + sovImages(uint64(l))
-	}
-	return n
+
+type JobWatchResponse struct {
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// progress of a pull or push
+	Progress []ImageStatus `protobuf:"bytes,2,rep,name=progress,proto3" json:"progress"`
+	// progress of a build
+	Build                *ImageBuildStatusResponse `protobuf:"bytes,3,opt,name=build,proto3" json:"build,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *ImageListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovImages(uint64(l))
+func (m *JobWatchResponse) Reset()      { *m = JobWatchResponse{} }
+func (*JobWatchResponse) ProtoMessage() {}
+func (*JobWatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{42}
+}
+func (m *JobWatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobWatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobWatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *JobWatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobWatchResponse.Merge(m, src)
+}
+func (m *JobWatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobWatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobWatchResponse.DiscardUnknown(m)
 }
 
-func (m *ImagePullRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovImages(uint64(l))
-	}
-	if m.Auth != nil {
-		l = m.Auth.Size()
-		n += 1 + l + sovImages(uint64(l))
-	}
-	return n
-}
+var xxx_messageInfo_JobWatchResponse proto.InternalMessageInfo
 
-func (m *ImagePullResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovImages(uint64(l))
+func (m *JobWatchResponse) GetJob() *Job {
+	if m != nil {
+		return m.Job
 	}
-	return n
+	return nil
 }
 
-func (m *ImagePushRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovImages(uint64(l))
-	}
-	if m.Auth != nil {
-		l = m.Auth.Size()
-		n += 1 + l + sovImages(uint64(l))
+func (m *JobWatchResponse) GetProgress() []ImageStatus {
+	if m != nil {
+		return m.Progress
 	}
-	return n
+	return nil
 }
 
-func (m *ImagePushResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovImages(uint64(l))
+func (m *JobWatchResponse) GetBuild() *ImageBuildStatusResponse {
+	if m != nil {
+		return m.Build
 	}
-	return n
+	return nil
 }
 
-func (m *ImageProgressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovImages(uint64(l))
-	}
-	return n
+type JobCancelRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageProgressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		for _, e := range m.Status {
-			l = e.Size()
-			n += 1 + l + sovImages(uint64(l))
+func (m *JobCancelRequest) Reset()      { *m = JobCancelRequest{} }
+func (*JobCancelRequest) ProtoMessage() {}
+func (*JobCancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{43}
+}
+func (m *JobCancelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobCancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobCancelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *ImageStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Ref)
-	if l > 0 {
-		n += 1 + l + sovImages(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovImages(uint64(l))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovImages(uint64(m.Offset))
-	}
-	if m.Total != 0 {
-		n += 1 + sovImages(uint64(m.Total))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
-	n += 1 + l + sovImages(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovImages(uint64(l))
-	return n
+func (m *JobCancelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCancelRequest.Merge(m, src)
 }
-
-func (m *ImageRemoveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovImages(uint64(l))
-	}
-	return n
+func (m *JobCancelRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *ImageRemoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *JobCancelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCancelRequest.DiscardUnknown(m)
 }
 
-func (m *ImageStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovImages(uint64(l))
+var xxx_messageInfo_JobCancelRequest proto.InternalMessageInfo
+
+func (m *JobCancelRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return ""
 }
 
-func (m *ImageStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovImages(uint64(l))
-	}
-	return n
+type JobCancelResponse struct {
+	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImageTagRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovImages(uint64(l))
-	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
-			n += 1 + l + sovImages(uint64(l))
+func (m *JobCancelResponse) Reset()      { *m = JobCancelResponse{} }
+func (*JobCancelResponse) ProtoMessage() {}
+func (*JobCancelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c65cb1807988f9, []int{44}
+}
+func (m *JobCancelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobCancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobCancelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *JobCancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCancelResponse.Merge(m, src)
+}
+func (m *JobCancelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobCancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCancelResponse.DiscardUnknown(m)
 }
 
-func (m *ImageTagResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovImages(uint64(l))
+var xxx_messageInfo_JobCancelResponse proto.InternalMessageInfo
+
+func (m *JobCancelResponse) GetJob() *Job {
+	if m != nil {
+		return m.Job
 	}
-	return n
+	return nil
 }
 
-func sovImages(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozImages(x uint64) (n int) {
-	return sovImages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func init() {
+	proto.RegisterType((*ImageBuildRequest)(nil), "kim.services.images.v1alpha1.ImageBuildRequest")
+	proto.RegisterMapType((map[string]string)(nil), "kim.services.images.v1alpha1.ImageBuildRequest.ExporterAttrsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "kim.services.images.v1alpha1.ImageBuildRequest.FrontendAttrsEntry")
+	proto.RegisterMapType((map[string]*pb.Definition)(nil), "kim.services.images.v1alpha1.ImageBuildRequest.FrontendInputsEntry")
+	proto.RegisterType((*ImageBuildResponse)(nil), "kim.services.images.v1alpha1.ImageBuildResponse")
+	proto.RegisterMapType((map[string]string)(nil), "kim.services.images.v1alpha1.ImageBuildResponse.ExporterResponseEntry")
+	proto.RegisterType((*ImageBuildStatusRequest)(nil), "kim.services.images.v1alpha1.ImageBuildStatusRequest")
+	proto.RegisterType((*ImageBuildStatusResponse)(nil), "kim.services.images.v1alpha1.ImageBuildStatusResponse")
+	proto.RegisterType((*ImageListRequest)(nil), "kim.services.images.v1alpha1.ImageListRequest")
+	proto.RegisterType((*ImageListResponse)(nil), "kim.services.images.v1alpha1.ImageListResponse")
+	proto.RegisterMapType((map[string]*ImageMetadata)(nil), "kim.services.images.v1alpha1.ImageListResponse.MetadataEntry")
+	proto.RegisterType((*ImageMetadata)(nil), "kim.services.images.v1alpha1.ImageMetadata")
+	proto.RegisterMapType((map[string]string)(nil), "kim.services.images.v1alpha1.ImageMetadata.LabelsEntry")
+	proto.RegisterType((*ImagePullRequest)(nil), "kim.services.images.v1alpha1.ImagePullRequest")
+	proto.RegisterType((*ImagePullResponse)(nil), "kim.services.images.v1alpha1.ImagePullResponse")
+	proto.RegisterType((*ImagePushRequest)(nil), "kim.services.images.v1alpha1.ImagePushRequest")
+	proto.RegisterType((*ImagePushResponse)(nil), "kim.services.images.v1alpha1.ImagePushResponse")
+	proto.RegisterType((*ImageProgressRequest)(nil), "kim.services.images.v1alpha1.ImageProgressRequest")
+	proto.RegisterType((*ImageProgressResponse)(nil), "kim.services.images.v1alpha1.ImageProgressResponse")
+	proto.RegisterType((*ImageStatus)(nil), "kim.services.images.v1alpha1.ImageStatus")
+	proto.RegisterType((*ImageRemoveRequest)(nil), "kim.services.images.v1alpha1.ImageRemoveRequest")
+	proto.RegisterType((*ImageRemoveResponse)(nil), "kim.services.images.v1alpha1.ImageRemoveResponse")
+	proto.RegisterType((*ImageRemoveResult)(nil), "kim.services.images.v1alpha1.ImageRemoveResult")
+	proto.RegisterType((*ImagePruneRequest)(nil), "kim.services.images.v1alpha1.ImagePruneRequest")
+	proto.RegisterType((*ImagePruneResponse)(nil), "kim.services.images.v1alpha1.ImagePruneResponse")
+	proto.RegisterType((*ImagePruned)(nil), "kim.services.images.v1alpha1.ImagePruned")
+	proto.RegisterType((*ImageStatusRequest)(nil), "kim.services.images.v1alpha1.ImageStatusRequest")
+	proto.RegisterType((*ImageStatusResponse)(nil), "kim.services.images.v1alpha1.ImageStatusResponse")
+	proto.RegisterType((*ImageInspectRequest)(nil), "kim.services.images.v1alpha1.ImageInspectRequest")
+	proto.RegisterType((*ImageInspectResponse)(nil), "kim.services.images.v1alpha1.ImageInspectResponse")
+	proto.RegisterType((*ImageHistoryRequest)(nil), "kim.services.images.v1alpha1.ImageHistoryRequest")
+	proto.RegisterType((*ImageHistoryResponse)(nil), "kim.services.images.v1alpha1.ImageHistoryResponse")
+	proto.RegisterType((*ImageHistory)(nil), "kim.services.images.v1alpha1.ImageHistory")
+	proto.RegisterType((*InfoRequest)(nil), "kim.services.images.v1alpha1.InfoRequest")
+	proto.RegisterType((*InfoResponse)(nil), "kim.services.images.v1alpha1.InfoResponse")
+	proto.RegisterType((*VolumeUsage)(nil), "kim.services.images.v1alpha1.VolumeUsage")
+	proto.RegisterType((*ImageTagRequest)(nil), "kim.services.images.v1alpha1.ImageTagRequest")
+	proto.RegisterType((*ImageTagResponse)(nil), "kim.services.images.v1alpha1.ImageTagResponse")
+	proto.RegisterType((*ImageExportRequest)(nil), "kim.services.images.v1alpha1.ImageExportRequest")
+	proto.RegisterType((*ImageExportResponse)(nil), "kim.services.images.v1alpha1.ImageExportResponse")
+	proto.RegisterType((*ImageImportRequest)(nil), "kim.services.images.v1alpha1.ImageImportRequest")
+	proto.RegisterType((*ImageImportResponse)(nil), "kim.services.images.v1alpha1.ImageImportResponse")
+	proto.RegisterType((*Job)(nil), "kim.services.images.v1alpha1.Job")
+	proto.RegisterType((*JobListRequest)(nil), "kim.services.images.v1alpha1.JobListRequest")
+	proto.RegisterType((*JobListResponse)(nil), "kim.services.images.v1alpha1.JobListResponse")
+	proto.RegisterType((*JobGetRequest)(nil), "kim.services.images.v1alpha1.JobGetRequest")
+	proto.RegisterType((*JobGetResponse)(nil), "kim.services.images.v1alpha1.JobGetResponse")
+	proto.RegisterType((*JobWatchRequest)(nil), "kim.services.images.v1alpha1.JobWatchRequest")
+	proto.RegisterType((*JobWatchResponse)(nil), "kim.services.images.v1alpha1.JobWatchResponse")
+	proto.RegisterType((*JobCancelRequest)(nil), "kim.services.images.v1alpha1.JobCancelRequest")
+	proto.RegisterType((*JobCancelResponse)(nil), "kim.services.images.v1alpha1.JobCancelResponse")
 }
-func (this *ImageListRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageListRequest{`,
-		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ImageFilter", "v1alpha2.ImageFilter", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func init() {
+	proto.RegisterFile("pkg/apis/services/images/v1alpha1/images.proto", fileDescriptor_51c65cb1807988f9)
 }
-func (this *ImageListResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForImages := "[]*Image{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(fmt.Sprintf("%v", f), "Image", "v1alpha2.Image", 1) + ","
-	}
-	repeatedStringForImages += "}"
-	s := strings.Join([]string{`&ImageListResponse{`,
-		`Images:` + repeatedStringForImages + `,`,
-		`}`,
-	}, "")
-	return s
+
+var fileDescriptor_51c65cb1807988f9 = []byte{
+	// 2538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xf7, 0x68, 0xbf, 0xdf, 0x4a, 0xb2, 0xd4, 0x56, 0x92, 0xad, 0x49, 0x2c, 0x29, 0x93, 0x50,
+	0x51, 0x62, 0x6b, 0x56, 0x92, 0x71, 0x08, 0x4e, 0x41, 0x45, 0x92, 0xbf, 0xa4, 0xd8, 0xe5, 0x30,
+	0x76, 0x1c, 0xa0, 0x0a, 0xe4, 0xd9, 0x9d, 0xde, 0xdd, 0x91, 0x66, 0xa7, 0x27, 0xd3, 0xbd, 0xc2,
+	0xcb, 0x81, 0x4a, 0x15, 0x27, 0x38, 0xa5, 0xa0, 0x8a, 0xe2, 0xcc, 0x81, 0x1b, 0x7f, 0x02, 0x77,
+	0x1f, 0xa0, 0x8a, 0x23, 0xc5, 0x21, 0x10, 0xe7, 0xc6, 0x85, 0x03, 0x17, 0xb8, 0x51, 0xfd, 0x35,
+	0x3b, 0xa3, 0x95, 0xac, 0x59, 0xa9, 0x28, 0x38, 0xa9, 0x5f, 0xf7, 0x7b, 0xbf, 0x7e, 0xef, 0xf5,
+	0xeb, 0xf7, 0xde, 0xf4, 0x0a, 0xec, 0xe8, 0xa0, 0xdb, 0x74, 0x23, 0x9f, 0x36, 0x29, 0x8e, 0x0f,
+	0xfd, 0x36, 0xa6, 0x4d, 0xbf, 0xef, 0x76, 0x31, 0x6d, 0x1e, 0xae, 0xbb, 0x41, 0xd4, 0x73, 0xd7,
+	0x15, 0x6d, 0x47, 0x31, 0x61, 0x04, 0xbd, 0x76, 0xe0, 0xf7, 0x6d, 0xcd, 0x6a, 0xab, 0x25, 0xcd,
+	0x6a, 0x2e, 0x75, 0x09, 0xe9, 0x06, 0xb8, 0x29, 0x78, 0x5b, 0x83, 0x4e, 0x93, 0xf9, 0x7d, 0x4c,
+	0x99, 0xdb, 0x8f, 0xa4, 0xb8, 0xb9, 0xda, 0xf5, 0x59, 0x6f, 0xd0, 0xb2, 0xdb, 0xa4, 0xdf, 0xec,
+	0x92, 0x2e, 0x19, 0x71, 0x72, 0x4a, 0x10, 0x62, 0xa4, 0xd8, 0x37, 0x0e, 0xde, 0xa3, 0xb6, 0x4f,
+	0x9a, 0xed, 0xd8, 0x5f, 0x75, 0x23, 0xbf, 0x99, 0x28, 0x1b, 0x0f, 0x42, 0x0e, 0xad, 0x95, 0xdc,
+	0xe0, 0xb3, 0x4a, 0xe6, 0x6a, 0x6a, 0x8b, 0x3e, 0x69, 0x0d, 0x9b, 0xad, 0x81, 0x1f, 0x78, 0x07,
+	0x3e, 0x6b, 0x52, 0x12, 0x1c, 0xe2, 0xb8, 0x19, 0xb5, 0x9a, 0x24, 0x52, 0xf6, 0x98, 0xef, 0x9f,
+	0xc8, 0xcd, 0xf7, 0x4b, 0x7c, 0xd2, 0x26, 0x21, 0x8b, 0x49, 0xa0, 0xff, 0x4a, 0x61, 0xeb, 0x9f,
+	0x65, 0x98, 0xdf, 0xe1, 0x2e, 0xd8, 0xe2, 0x42, 0x0e, 0xfe, 0x74, 0x80, 0x29, 0x43, 0x73, 0x50,
+	0x70, 0x70, 0xa7, 0x61, 0x2c, 0x1b, 0x2b, 0x35, 0x87, 0x0f, 0x91, 0x0d, 0x70, 0x13, 0x77, 0xfc,
+	0xd0, 0x67, 0x3e, 0x09, 0x1b, 0x53, 0xcb, 0xc6, 0x4a, 0x7d, 0x63, 0xd6, 0x8e, 0x5a, 0xf6, 0x68,
+	0xd6, 0x49, 0x71, 0x20, 0x13, 0xaa, 0xb7, 0x9e, 0x46, 0x24, 0x66, 0x38, 0x6e, 0x14, 0x04, 0x4c,
+	0x42, 0xa3, 0x1e, 0xcc, 0xe8, 0xf1, 0x26, 0x63, 0x31, 0x6d, 0x14, 0x97, 0x0b, 0x2b, 0xf5, 0x8d,
+	0x2d, 0xfb, 0x45, 0x07, 0x63, 0x8f, 0x69, 0x69, 0x67, 0x40, 0x6e, 0x85, 0x2c, 0x1e, 0x3a, 0x59,
+	0x60, 0xd4, 0x80, 0xca, 0x43, 0x4c, 0x29, 0x57, 0xb9, 0x24, 0x94, 0xd0, 0x24, 0xd7, 0xef, 0x76,
+	0x4c, 0x42, 0x86, 0x43, 0xaf, 0x51, 0x96, 0xfa, 0x69, 0x9a, 0xeb, 0xa7, 0xc7, 0x52, 0xbf, 0xca,
+	0xd9, 0xf4, 0xcb, 0x80, 0x28, 0xfd, 0x32, 0x73, 0xe8, 0x06, 0x94, 0xb6, 0xdd, 0x76, 0x0f, 0x37,
+	0xaa, 0xc2, 0xa1, 0x8b, 0x36, 0x3f, 0x3f, 0x5b, 0x9f, 0x9f, 0x7d, 0xb8, 0x6e, 0x8b, 0xe5, 0x07,
+	0x11, 0xf7, 0x29, 0xdd, 0x2a, 0x3e, 0xfb, 0x62, 0xe9, 0x82, 0x23, 0x45, 0xd0, 0x0f, 0x61, 0xfa,
+	0x56, 0xc8, 0x7c, 0x16, 0xe0, 0x3e, 0x0e, 0x19, 0x6d, 0xd4, 0x96, 0x0b, 0x2b, 0xb5, 0xad, 0x1b,
+	0x7f, 0xf9, 0x62, 0xe9, 0xdd, 0x13, 0x03, 0x62, 0xc0, 0xfc, 0xa0, 0x89, 0x53, 0x52, 0x76, 0x0a,
+	0xc2, 0xc9, 0xe0, 0xa1, 0x03, 0x98, 0xd5, 0xca, 0xee, 0x84, 0xd1, 0x80, 0xd1, 0x06, 0x08, 0x37,
+	0x6c, 0x9f, 0xd5, 0x0d, 0x12, 0x45, 0xfa, 0xe1, 0x08, 0x34, 0x7a, 0x19, 0xca, 0x0f, 0x3f, 0x1d,
+	0xb8, 0xb4, 0xd7, 0xa8, 0x2f, 0x1b, 0x2b, 0x55, 0x47, 0x51, 0xe6, 0x07, 0x80, 0xc6, 0x4f, 0x99,
+	0x87, 0xe7, 0x01, 0x1e, 0xea, 0xf0, 0x3c, 0xc0, 0x43, 0xb4, 0x00, 0xa5, 0x43, 0x37, 0x18, 0x60,
+	0x11, 0x99, 0x35, 0x47, 0x12, 0x37, 0xa6, 0xde, 0x33, 0x38, 0xc2, 0xf8, 0x39, 0x4c, 0x84, 0xf0,
+	0x1d, 0xb8, 0x74, 0x8c, 0x09, 0xc7, 0x40, 0xbc, 0x99, 0x86, 0x18, 0xbf, 0x1e, 0x23, 0x48, 0xeb,
+	0x8f, 0x06, 0xa0, 0xb4, 0xa3, 0x68, 0x44, 0x42, 0x8a, 0x51, 0x0c, 0x73, 0xda, 0x5a, 0x3d, 0xd7,
+	0x30, 0x84, 0xd3, 0x6f, 0xe7, 0x77, 0xba, 0x94, 0xb3, 0x8f, 0x02, 0x49, 0xbf, 0x8f, 0xe1, 0x9b,
+	0xdb, 0xf0, 0xd2, 0xb1, 0xac, 0x93, 0xb8, 0xc8, 0xba, 0x02, 0xaf, 0x8c, 0x54, 0x78, 0xc8, 0x5c,
+	0x36, 0xa0, 0x27, 0xa6, 0x12, 0xeb, 0xf7, 0x06, 0x34, 0xc6, 0xb9, 0x95, 0x0b, 0xbe, 0x0e, 0xd5,
+	0x43, 0x1c, 0x33, 0xfc, 0x14, 0x53, 0x65, 0x7a, 0x63, 0xfc, 0x52, 0x3c, 0x16, 0x1c, 0x4e, 0xc2,
+	0x89, 0x6e, 0x40, 0x95, 0x0a, 0x1c, 0x4c, 0x1b, 0x53, 0xcb, 0x85, 0xe3, 0xaf, 0x92, 0x94, 0x52,
+	0xfb, 0x25, 0xfc, 0xa8, 0x09, 0xc5, 0x80, 0x74, 0x69, 0xa3, 0x20, 0xe4, 0x5e, 0x3d, 0x49, 0xee,
+	0x1e, 0xe9, 0x3a, 0x82, 0xd1, 0xfa, 0xa9, 0x01, 0x73, 0x42, 0xff, 0x7b, 0x3e, 0x65, 0xda, 0xcc,
+	0xeb, 0x50, 0xee, 0xf8, 0x01, 0xcf, 0x76, 0x86, 0x38, 0xfc, 0xcb, 0xb6, 0xca, 0xef, 0xfa, 0x90,
+	0x36, 0xe4, 0x21, 0xdd, 0x16, 0x4c, 0x8e, 0x62, 0xe6, 0x09, 0x4a, 0x8e, 0xa4, 0xde, 0x35, 0x47,
+	0x93, 0x68, 0x11, 0x20, 0xc6, 0x1d, 0x1c, 0xe3, 0xb0, 0x8d, 0xa5, 0x72, 0x35, 0x27, 0x35, 0x63,
+	0xfd, 0x6c, 0x0a, 0xe6, 0x53, 0x5a, 0x28, 0xf7, 0x35, 0xa1, 0x2c, 0x63, 0x43, 0x39, 0xef, 0x95,
+	0x13, 0xd4, 0x70, 0x14, 0x1b, 0xfa, 0x1e, 0x54, 0xfb, 0x98, 0xb9, 0x9e, 0xcb, 0x5c, 0xe5, 0xb9,
+	0x6f, 0xe5, 0x08, 0xb5, 0xf4, 0x9e, 0xf6, 0x7d, 0x25, 0x2f, 0x23, 0x2c, 0x81, 0x33, 0x7b, 0x30,
+	0x93, 0x59, 0x3a, 0x26, 0xa2, 0x36, 0xb3, 0x37, 0xe6, 0x4a, 0x8e, 0xad, 0x35, 0x64, 0x3a, 0xfc,
+	0xfe, 0x6d, 0xc0, 0x4c, 0x66, 0x11, 0x7d, 0x1b, 0x2a, 0xed, 0x18, 0xbb, 0x0c, 0x7b, 0xea, 0x3c,
+	0x4c, 0x5b, 0xd6, 0x75, 0x5b, 0x57, 0x6b, 0xfb, 0x91, 0xae, 0xeb, 0x5b, 0x55, 0x9e, 0x56, 0x3f,
+	0xff, 0xeb, 0x92, 0xe1, 0x68, 0x21, 0xf4, 0x00, 0xca, 0x81, 0xdb, 0xc2, 0x81, 0x0e, 0xa7, 0x6f,
+	0x4c, 0xa0, 0x99, 0x7d, 0x4f, 0x48, 0x4a, 0x77, 0x28, 0x18, 0xf4, 0x1a, 0xd4, 0xa2, 0xc0, 0x65,
+	0x1d, 0x12, 0xf7, 0xf5, 0x69, 0x8e, 0x26, 0xcc, 0x6f, 0x42, 0x3d, 0x25, 0x34, 0xd1, 0xd5, 0xfb,
+	0x95, 0x8e, 0xc6, 0x8f, 0x06, 0x41, 0xa0, 0xa3, 0x71, 0x1d, 0x4a, 0x42, 0x45, 0x65, 0xfc, 0xab,
+	0x27, 0x44, 0xc1, 0xc3, 0x08, 0xb7, 0x1d, 0xc9, 0x89, 0xd6, 0xa0, 0xe8, 0x0e, 0x58, 0x4f, 0x9d,
+	0xc4, 0x6b, 0xe3, 0x12, 0x9b, 0x03, 0xd6, 0xdb, 0x26, 0x61, 0xc7, 0xef, 0x3a, 0x82, 0x13, 0x5d,
+	0xe6, 0x11, 0x2a, 0xf6, 0xdb, 0xf3, 0x3d, 0x55, 0xe4, 0x6b, 0x6a, 0x66, 0xc7, 0xb3, 0x3e, 0x80,
+	0xf9, 0x94, 0x5e, 0x2a, 0x3e, 0x17, 0xd2, 0x8a, 0xd5, 0xf4, 0xde, 0x2f, 0x41, 0x79, 0x9f, 0xb4,
+	0x38, 0x8a, 0x32, 0x6f, 0x9f, 0xb4, 0x76, 0xbc, 0xb4, 0x69, 0xb4, 0xf7, 0xff, 0x69, 0x1a, 0xed,
+	0x9d, 0xcd, 0xb4, 0x0f, 0x61, 0x41, 0x22, 0xc4, 0xa4, 0x1b, 0x63, 0x9a, 0x64, 0xcb, 0xe3, 0x41,
+	0xb2, 0xea, 0x4c, 0x1d, 0x55, 0xe7, 0x09, 0xbc, 0x74, 0x04, 0x4c, 0xa9, 0x74, 0x07, 0xca, 0x32,
+	0xcd, 0xa9, 0x6c, 0xf0, 0x76, 0x8e, 0x28, 0x96, 0xf9, 0x51, 0xb5, 0x1a, 0x4a, 0xdc, 0xfa, 0x87,
+	0x01, 0xf5, 0xd4, 0x2a, 0x0f, 0xd0, 0x78, 0x94, 0xd4, 0x63, 0xdc, 0xe1, 0x05, 0x5c, 0x6d, 0x25,
+	0xd5, 0x53, 0x14, 0x9f, 0x27, 0x9d, 0x0e, 0xc5, 0x4c, 0x78, 0xb1, 0xe0, 0x28, 0x8a, 0x1b, 0xca,
+	0x08, 0x73, 0x83, 0x46, 0x51, 0x4c, 0x4b, 0x02, 0x6d, 0x03, 0x50, 0xe6, 0xc6, 0x0c, 0x7b, 0x7b,
+	0x2e, 0x6b, 0x94, 0x26, 0xb8, 0xb9, 0x35, 0x25, 0xb7, 0xc9, 0x38, 0xc8, 0x20, 0xf2, 0x5c, 0x05,
+	0x52, 0x9e, 0x04, 0x44, 0xc9, 0x6d, 0x32, 0xeb, 0x17, 0xba, 0x42, 0x3b, 0xb8, 0x4f, 0x0e, 0xf1,
+	0x39, 0xa2, 0xef, 0x5a, 0x92, 0x92, 0xa7, 0x96, 0x0b, 0xa7, 0xc9, 0xe8, 0xb4, 0xbc, 0x00, 0xa5,
+	0x0e, 0x89, 0xdb, 0x58, 0x78, 0xad, 0xea, 0x48, 0xc2, 0x7a, 0x02, 0x97, 0x32, 0x3a, 0xa9, 0x63,
+	0xde, 0x81, 0x4a, 0x8c, 0xe9, 0x20, 0x60, 0xfa, 0x9c, 0x9b, 0x39, 0xce, 0x39, 0xc1, 0x18, 0x04,
+	0xcc, 0xd1, 0xf2, 0xd6, 0x6f, 0x0d, 0x98, 0x1f, 0x5b, 0x3e, 0x8b, 0xd5, 0x26, 0x54, 0x07, 0x21,
+	0x73, 0xbb, 0x5d, 0xec, 0xa9, 0xca, 0x96, 0xd0, 0xbc, 0xe8, 0x79, 0x38, 0xc0, 0x3c, 0x39, 0xcb,
+	0xab, 0xa5, 0x49, 0x84, 0xa0, 0xd8, 0x26, 0x1e, 0x16, 0x41, 0x51, 0x72, 0xc4, 0x98, 0xbb, 0x02,
+	0xc7, 0x31, 0x89, 0x55, 0x07, 0x2f, 0x09, 0xeb, 0xbb, 0xfa, 0x0a, 0xc6, 0x83, 0x10, 0xa7, 0x7a,
+	0x0d, 0x37, 0x08, 0x84, 0x96, 0x55, 0x87, 0x0f, 0x5f, 0x50, 0x5f, 0x5f, 0x81, 0x8a, 0x17, 0x0f,
+	0xf7, 0xe2, 0x41, 0xa8, 0x7c, 0x5c, 0xf6, 0xe2, 0xa1, 0x33, 0x08, 0xad, 0xcf, 0xf4, 0xc9, 0x2b,
+	0x68, 0xe5, 0xe4, 0xcd, 0x23, 0x95, 0x35, 0xcf, 0x5d, 0x12, 0x08, 0x5e, 0x72, 0xa8, 0x6f, 0xc1,
+	0x45, 0x1a, 0xb9, 0x6d, 0xbc, 0x17, 0xe3, 0x76, 0xe0, 0xfa, 0x7d, 0x2c, 0xef, 0x72, 0xc1, 0x99,
+	0x15, 0xd3, 0x8e, 0x9e, 0xb5, 0x1e, 0x40, 0x3d, 0x25, 0xcf, 0x6b, 0x47, 0xe8, 0xf6, 0xb1, 0x60,
+	0x52, 0x77, 0x6e, 0x34, 0x81, 0x66, 0x61, 0x2a, 0x49, 0x0a, 0x53, 0xbe, 0xf0, 0x61, 0x8c, 0x3b,
+	0xba, 0xc8, 0x88, 0xb1, 0x75, 0x07, 0x50, 0xea, 0xfa, 0x9e, 0x3d, 0x98, 0xad, 0x9b, 0x70, 0x29,
+	0x03, 0xa4, 0x9c, 0xb3, 0x9a, 0x45, 0x3a, 0xb1, 0xeb, 0x50, 0x28, 0x9e, 0x42, 0xd9, 0x09, 0x69,
+	0x84, 0xdb, 0xec, 0x1c, 0x97, 0xcb, 0x84, 0xaa, 0xae, 0xa2, 0xca, 0x05, 0x09, 0x6d, 0xfd, 0xcb,
+	0x80, 0x85, 0xec, 0x36, 0x67, 0xd2, 0x96, 0x3b, 0x94, 0x7b, 0x5b, 0xe1, 0x8b, 0x31, 0xcf, 0xc8,
+	0x7d, 0xec, 0xf9, 0xee, 0x1e, 0x1b, 0x46, 0x58, 0x17, 0x08, 0x31, 0xf3, 0x68, 0x18, 0x61, 0x9e,
+	0xf5, 0x3c, 0xbf, 0x8b, 0x29, 0x13, 0x91, 0x5c, 0x73, 0x14, 0x25, 0xd2, 0x7b, 0xe8, 0xe1, 0xa7,
+	0x22, 0x96, 0xa7, 0x1d, 0x49, 0x70, 0x23, 0xfa, 0x6e, 0xe8, 0x77, 0x30, 0x95, 0xe9, 0x6a, 0xda,
+	0x49, 0x68, 0x8e, 0xd4, 0x16, 0x95, 0xa9, 0x51, 0x11, 0x2b, 0x8a, 0xca, 0xf6, 0x13, 0xd5, 0x23,
+	0xfd, 0x44, 0xe2, 0xe0, 0xbb, 0x3e, 0x65, 0x24, 0x1e, 0xfe, 0x97, 0x1c, 0x1c, 0xc3, 0x42, 0x76,
+	0x17, 0xe5, 0x5f, 0x19, 0x91, 0x46, 0x12, 0x91, 0xbb, 0x50, 0xe9, 0x49, 0x16, 0x95, 0x02, 0xdf,
+	0xc9, 0x71, 0x77, 0x14, 0xa8, 0x2a, 0x44, 0x1a, 0xc0, 0xfa, 0xbb, 0x01, 0xd3, 0xe9, 0xf5, 0x73,
+	0x77, 0x7a, 0x97, 0x01, 0xd4, 0x70, 0xaf, 0x35, 0xd4, 0xb5, 0x55, 0xcd, 0x6c, 0x0d, 0xb9, 0xff,
+	0x79, 0x47, 0x40, 0xf4, 0x2b, 0x86, 0xa2, 0x78, 0x62, 0x69, 0x93, 0x3e, 0xff, 0x52, 0x56, 0x47,
+	0xac, 0x49, 0xb4, 0x04, 0x75, 0xdc, 0x8f, 0xd8, 0x70, 0x2f, 0x70, 0x87, 0x58, 0x66, 0xad, 0xaa,
+	0x03, 0x62, 0xea, 0x1e, 0x9f, 0xe1, 0x41, 0x20, 0x97, 0xe4, 0xbb, 0x83, 0x24, 0x78, 0x94, 0x51,
+	0xff, 0xc7, 0x58, 0x1c, 0x73, 0xc1, 0x11, 0x63, 0x6b, 0x06, 0xea, 0x3b, 0x61, 0x87, 0xa8, 0xe3,
+	0xb3, 0x7e, 0x37, 0x05, 0xd3, 0x92, 0x56, 0x8e, 0x6e, 0x40, 0xe5, 0x10, 0xc7, 0xe2, 0x79, 0x43,
+	0x7a, 0x5b, 0x93, 0xdc, 0xaa, 0xae, 0xcf, 0xf6, 0xb8, 0x4e, 0x3e, 0xd3, 0x56, 0x75, 0x7d, 0xb6,
+	0x2d, 0x26, 0x32, 0xa7, 0x5a, 0xc8, 0x9e, 0x2a, 0xba, 0x02, 0xf3, 0xfc, 0x89, 0xc8, 0xf5, 0x43,
+	0x1c, 0x7b, 0x7b, 0x94, 0xb4, 0x0f, 0xb0, 0xb6, 0x71, 0x6e, 0xb4, 0xf0, 0x50, 0xcc, 0xa3, 0x55,
+	0x40, 0x29, 0x66, 0xad, 0x8c, 0xcc, 0xd4, 0x29, 0x98, 0xc7, 0x4a, 0xad, 0xb7, 0xe0, 0xa2, 0xfe,
+	0xb2, 0xd2, 0xc8, 0xd2, 0x09, 0xb3, 0x7a, 0x5a, 0xe1, 0x6e, 0x43, 0xe5, 0x90, 0x04, 0x83, 0x3e,
+	0xd6, 0x8f, 0x2f, 0xa7, 0xa4, 0xdb, 0xc7, 0x82, 0xf9, 0x63, 0xca, 0xaf, 0xad, 0x96, 0xb4, 0x7e,
+	0x69, 0x40, 0x3d, 0xb5, 0x90, 0x5c, 0x64, 0x23, 0x75, 0x91, 0x11, 0x14, 0x23, 0x57, 0xf5, 0x86,
+	0x35, 0x47, 0x8c, 0x47, 0xbd, 0x09, 0x77, 0x4d, 0x51, 0xf7, 0x26, 0x08, 0x8a, 0x03, 0x8a, 0x3d,
+	0xe1, 0x8a, 0xa2, 0x23, 0xc6, 0xfc, 0x16, 0xba, 0x87, 0xae, 0x1f, 0xb8, 0xad, 0x00, 0x0b, 0xab,
+	0x8b, 0xce, 0x68, 0x62, 0x54, 0xb9, 0xca, 0xd9, 0xca, 0x75, 0x51, 0x04, 0xf0, 0x23, 0xb7, 0x7b,
+	0x8e, 0x7b, 0x89, 0xa0, 0xc8, 0xdc, 0xae, 0xae, 0x6a, 0x62, 0x6c, 0x6d, 0xc2, 0xdc, 0x08, 0xf9,
+	0x6c, 0x99, 0xf9, 0xe7, 0xba, 0xf8, 0xc9, 0x37, 0x01, 0xad, 0xe0, 0xb5, 0x23, 0xc5, 0x2f, 0x57,
+	0x0f, 0xf3, 0x82, 0xd4, 0x81, 0xde, 0x80, 0x19, 0x37, 0x08, 0xf6, 0xd2, 0x9f, 0x44, 0xfc, 0x9a,
+	0x4c, 0xbb, 0x41, 0xf0, 0x51, 0x92, 0xc5, 0xde, 0x86, 0x4b, 0x19, 0x5d, 0x94, 0x49, 0x08, 0x8a,
+	0xe2, 0x73, 0xd5, 0x10, 0x09, 0x51, 0x8c, 0xad, 0x15, 0xa5, 0xf6, 0x4e, 0x3f, 0xad, 0xf6, 0x71,
+	0x9c, 0xab, 0x70, 0x29, 0xc3, 0xa9, 0x40, 0x5f, 0xce, 0x58, 0x58, 0xd3, 0x46, 0xf0, 0xcf, 0xec,
+	0xc2, 0x2e, 0x69, 0x8d, 0xe5, 0x34, 0x04, 0xc5, 0x03, 0x3f, 0xd4, 0x75, 0x57, 0x8c, 0x47, 0xcd,
+	0x7b, 0x21, 0xdd, 0xbc, 0x2f, 0x40, 0x89, 0xf7, 0xc2, 0x58, 0xdd, 0x21, 0x49, 0x1c, 0xdf, 0xd5,
+	0xf0, 0xd6, 0x55, 0x27, 0xa3, 0x49, 0x5b, 0x57, 0x25, 0xb7, 0xc9, 0xd0, 0x26, 0xd4, 0xf9, 0x9b,
+	0x13, 0xed, 0x49, 0x94, 0xca, 0xa9, 0x28, 0x45, 0x81, 0x00, 0x5a, 0x68, 0x53, 0xd4, 0x29, 0xf2,
+	0xa3, 0x10, 0xc7, 0xe2, 0x5d, 0xb2, 0xe6, 0x48, 0xc2, 0xb2, 0x60, 0x76, 0x97, 0xb4, 0xd2, 0xaf,
+	0x1e, 0x63, 0x0d, 0x97, 0x75, 0x17, 0x2e, 0x26, 0x3c, 0xca, 0xb5, 0xd7, 0xa1, 0xb8, 0x4f, 0x5a,
+	0x3a, 0x74, 0x5e, 0x7f, 0xf1, 0x45, 0xde, 0x25, 0x2d, 0x47, 0xb0, 0x5b, 0x4b, 0x30, 0xb3, 0x4b,
+	0x5a, 0x77, 0x70, 0xb2, 0xd9, 0x91, 0x23, 0xb0, 0x6e, 0xc1, 0xac, 0x66, 0x50, 0x3b, 0x5d, 0x83,
+	0xc2, 0x3e, 0x69, 0xa9, 0x50, 0xcf, 0xb1, 0x11, 0xe7, 0xb6, 0x5e, 0x17, 0x1a, 0x7f, 0xe2, 0xb2,
+	0x76, 0xef, 0xa4, 0x9d, 0x9e, 0x1b, 0x30, 0x37, 0xe2, 0x39, 0xc7, 0x66, 0xe8, 0x43, 0xa8, 0x46,
+	0xea, 0x2b, 0xad, 0x31, 0x95, 0x27, 0xb1, 0x8d, 0x7f, 0x93, 0x25, 0x00, 0xe8, 0x1e, 0x94, 0x44,
+	0xda, 0x14, 0xf1, 0x56, 0xdf, 0x78, 0x37, 0xef, 0x1b, 0x61, 0xb6, 0x79, 0x73, 0x24, 0x88, 0x65,
+	0x09, 0x1b, 0xb7, 0xdd, 0xb0, 0x8d, 0x83, 0x93, 0x1c, 0x71, 0x17, 0xe6, 0x53, 0x3c, 0xe7, 0x70,
+	0xc4, 0xc6, 0x1f, 0xe6, 0xa0, 0x2c, 0x34, 0xa2, 0x68, 0x1f, 0x4a, 0x42, 0x2d, 0xd4, 0x9c, 0xf0,
+	0x65, 0xd9, 0x5c, 0x9b, 0xf4, 0x55, 0x14, 0xfd, 0x04, 0xea, 0x29, 0x17, 0xa0, 0xeb, 0x93, 0xba,
+	0x4c, 0xee, 0x7b, 0x46, 0x4f, 0xaf, 0x19, 0xc8, 0x81, 0x69, 0xb9, 0xa0, 0x7e, 0x86, 0x38, 0xe6,
+	0x99, 0x72, 0x6b, 0xc8, 0x30, 0xbd, 0x8f, 0x29, 0x2f, 0x59, 0xe6, 0x29, 0xeb, 0x2b, 0xc6, 0x9a,
+	0x81, 0xfa, 0x50, 0x56, 0xe6, 0xac, 0xe5, 0x8e, 0x25, 0x6d, 0xc9, 0xfa, 0x04, 0x12, 0xca, 0x85,
+	0x11, 0x54, 0x54, 0x43, 0x8d, 0xf2, 0x48, 0x67, 0x7b, 0x7c, 0x73, 0x63, 0x12, 0x91, 0xd1, 0x8e,
+	0xba, 0xdb, 0x5b, 0xcf, 0xdf, 0x39, 0x4e, 0xb2, 0xe3, 0xd1, 0x0e, 0xb6, 0x0b, 0x45, 0x9e, 0xc2,
+	0x90, 0x9d, 0xfb, 0x2d, 0x54, 0xee, 0xd5, 0x9c, 0xf0, 0xed, 0x94, 0x6f, 0xc4, 0xdf, 0xc7, 0x72,
+	0x6d, 0x94, 0x7a, 0xe0, 0x33, 0x9b, 0xb9, 0xf9, 0xd5, 0x46, 0x43, 0x98, 0xe6, 0xb4, 0x7e, 0x22,
+	0x42, 0x79, 0xbc, 0x72, 0xe4, 0x71, 0xca, 0xbc, 0x36, 0x91, 0x4c, 0x12, 0xf3, 0xc2, 0x46, 0xda,
+	0xcb, 0x69, 0x23, 0xed, 0x4d, 0x66, 0x23, 0xed, 0x65, 0x6d, 0xa4, 0xbd, 0xff, 0x85, 0x8d, 0x7d,
+	0x28, 0xcb, 0x17, 0x93, 0x5c, 0x77, 0x30, 0xf3, 0xa6, 0x64, 0xae, 0x4f, 0x20, 0xa1, 0x2c, 0xdd,
+	0x87, 0x92, 0x78, 0x1b, 0xc8, 0x95, 0x32, 0xd3, 0x4f, 0x24, 0xe6, 0x5a, 0x7e, 0x01, 0xb5, 0x97,
+	0x07, 0x85, 0x47, 0x6e, 0x17, 0xad, 0xe6, 0x10, 0x1c, 0xb5, 0xb4, 0xa6, 0x9d, 0x97, 0x5d, 0xed,
+	0x42, 0xa0, 0x2c, 0xdb, 0xbc, 0x5c, 0x0e, 0xcc, 0x74, 0xa7, 0xe6, 0xfa, 0x04, 0x12, 0xc9, 0x89,
+	0x11, 0x5e, 0x7f, 0x72, 0x6f, 0xb8, 0xd3, 0x9f, 0x74, 0xc3, 0x6c, 0x7f, 0xb9, 0x62, 0xa0, 0x1f,
+	0x40, 0x91, 0x7f, 0xbc, 0xa1, 0xd3, 0x0a, 0xfe, 0xe8, 0x83, 0xcf, 0x7c, 0x27, 0x0f, 0xab, 0x72,
+	0xe0, 0xc7, 0x00, 0xe2, 0x77, 0x61, 0xf9, 0xa9, 0x63, 0x8d, 0xd7, 0x8d, 0x9b, 0x3e, 0x3d, 0x10,
+	0x8b, 0x1a, 0xfd, 0x8d, 0x17, 0xf2, 0x28, 0xd8, 0xfb, 0x0a, 0x56, 0x86, 0xdb, 0x31, 0xe5, 0x28,
+	0x13, 0x5d, 0x97, 0xc7, 0xd7, 0x15, 0x5c, 0x9b, 0xc4, 0xde, 0x9a, 0x81, 0x1e, 0x43, 0xe5, 0x13,
+	0x12, 0x1f, 0xf0, 0x07, 0xb8, 0x37, 0xc7, 0x79, 0x79, 0x6a, 0x54, 0xcb, 0x1a, 0xf1, 0x6b, 0xa7,
+	0x70, 0x49, 0x35, 0x37, 0x7e, 0x53, 0x80, 0xe2, 0x2e, 0x69, 0x51, 0xd4, 0x56, 0x99, 0xfb, 0xea,
+	0xa9, 0x7d, 0x48, 0x3a, 0x6f, 0xaf, 0xe6, 0xe4, 0x56, 0x4e, 0x79, 0x02, 0x85, 0x3b, 0x98, 0xa1,
+	0x2b, 0xa7, 0x4a, 0x8d, 0xba, 0x57, 0xf3, 0x6a, 0x3e, 0x66, 0xb5, 0x43, 0x0f, 0x4a, 0xa2, 0xdb,
+	0x44, 0xa7, 0x6b, 0x96, 0xee, 0x5c, 0x4d, 0x3b, 0x2f, 0x7b, 0x72, 0x0f, 0x7c, 0x28, 0xcb, 0x7e,
+	0x0e, 0x9d, 0x2e, 0x9b, 0x69, 0x0e, 0xcd, 0x66, 0x6e, 0x7e, 0xb9, 0xd9, 0xd6, 0xee, 0xb3, 0x2f,
+	0x17, 0x8d, 0x3f, 0x7f, 0xb9, 0x78, 0xe1, 0xb3, 0xe7, 0x8b, 0xc6, 0xb3, 0xe7, 0x8b, 0xc6, 0x9f,
+	0x9e, 0x2f, 0x1a, 0x7f, 0x7b, 0xbe, 0x68, 0x7c, 0xfe, 0xd5, 0xe2, 0x85, 0x5f, 0x7f, 0xb5, 0x78,
+	0xe1, 0xfb, 0x2b, 0xa7, 0xfe, 0x2b, 0xcf, 0xfb, 0x92, 0x6e, 0x95, 0xc5, 0x77, 0xcc, 0xb5, 0xff,
+	0x0c, 0x00, 0x62, 0x42, 0x38, 0x57, 0xfd, 0x23, 0x00, 0x00,
 }
-func (this *ImagePullRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImagePullRequest{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
-		`Auth:` + strings.Replace(fmt.Sprintf("%v", this.Auth), "AuthConfig", "v1alpha2.AuthConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ImagesClient is the client API for Images service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ImagesClient interface {
+	// Build an image
+	Build(ctx context.Context, in *ImageBuildRequest, opts ...grpc.CallOption) (*ImageBuildResponse, error)
+	BuildStatus(ctx context.Context, in *ImageBuildStatusRequest, opts ...grpc.CallOption) (Images_BuildStatusClient, error)
+	BuildSession(ctx context.Context, opts ...grpc.CallOption) (Images_BuildSessionClient, error)
+	// Status of an image
+	Status(ctx context.Context, in *ImageStatusRequest, opts ...grpc.CallOption) (*ImageStatusResponse, error)
+	// Inspect an image, returning the raw index, manifest and config from the content store
+	Inspect(ctx context.Context, in *ImageInspectRequest, opts ...grpc.CallOption) (*ImageInspectResponse, error)
+	// History of an image, per layer
+	History(ctx context.Context, in *ImageHistoryRequest, opts ...grpc.CallOption) (*ImageHistoryResponse, error)
+	// List images
+	List(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error)
+	// Pull an image
+	Pull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (*ImagePullResponse, error)
+	PullProgress(ctx context.Context, in *ImageProgressRequest, opts ...grpc.CallOption) (Images_PullProgressClient, error)
+	// Push an image
+	Push(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (*ImagePushResponse, error)
+	PushProgress(ctx context.Context, in *ImageProgressRequest, opts ...grpc.CallOption) (Images_PushProgressClient, error)
+	// Remove an image
+	Remove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error)
+	// Prune unused images
+	Prune(ctx context.Context, in *ImagePruneRequest, opts ...grpc.CallOption) (*ImagePruneResponse, error)
+	// Tag an image
+	Tag(ctx context.Context, in *ImageTagRequest, opts ...grpc.CallOption) (*ImageTagResponse, error)
+	// Export image(s) as a tarball
+	Export(ctx context.Context, in *ImageExportRequest, opts ...grpc.CallOption) (Images_ExportClient, error)
+	// Import image(s) from a tarball
+	Import(ctx context.Context, opts ...grpc.CallOption) (Images_ImportClient, error)
+	// Info about the agent and its backends, for diagnostics
+	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// Disk usage of the build cache, proxied to buildkitd
+	CacheUsage(ctx context.Context, in *control.DiskUsageRequest, opts ...grpc.CallOption) (*control.DiskUsageResponse, error)
+	// Prune the build cache, proxied to buildkitd
+	CachePrune(ctx context.Context, in *control.PruneRequest, opts ...grpc.CallOption) (Images_CachePruneClient, error)
+	// Workers of buildkitd, proxied to buildkitd
+	Workers(ctx context.Context, in *control.ListWorkersRequest, opts ...grpc.CallOption) (*control.ListWorkersResponse, error)
 }
-func (this *ImagePullResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImagePullResponse{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`}`,
-	}, "")
-	return s
+
+type imagesClient struct {
+	cc *grpc.ClientConn
 }
-func (this *ImagePushRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImagePushRequest{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
-		`Auth:` + strings.Replace(fmt.Sprintf("%v", this.Auth), "AuthConfig", "v1alpha2.AuthConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func NewImagesClient(cc *grpc.ClientConn) ImagesClient {
+	return &imagesClient{cc}
 }
-func (this *ImagePushResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *imagesClient) Build(ctx context.Context, in *ImageBuildRequest, opts ...grpc.CallOption) (*ImageBuildResponse, error) {
+	out := new(ImageBuildResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Build", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ImagePushResponse{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ImageProgressRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *imagesClient) BuildStatus(ctx context.Context, in *ImageBuildStatusRequest, opts ...grpc.CallOption) (Images_BuildStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Images_serviceDesc.Streams[0], "/kim.services.images.v1alpha1.Images/BuildStatus", opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ImageProgressRequest{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`}`,
-	}, "")
-	return s
+	x := &imagesBuildStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (this *ImageProgressResponse) String() string {
-	if this == nil {
-		return "nil"
+
+type Images_BuildStatusClient interface {
+	Recv() (*ImageBuildStatusResponse, error)
+	grpc.ClientStream
+}
+
+type imagesBuildStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *imagesBuildStatusClient) Recv() (*ImageBuildStatusResponse, error) {
+	m := new(ImageBuildStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	repeatedStringForStatus := "[]ImageStatus{"
-	for _, f := range this.Status {
-		repeatedStringForStatus += strings.Replace(strings.Replace(f.String(), "ImageStatus", "ImageStatus", 1), `&`, ``, 1) + ","
+	return m, nil
+}
+
+func (c *imagesClient) BuildSession(ctx context.Context, opts ...grpc.CallOption) (Images_BuildSessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Images_serviceDesc.Streams[1], "/kim.services.images.v1alpha1.Images/BuildSession", opts...)
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForStatus += "}"
-	s := strings.Join([]string{`&ImageProgressResponse{`,
-		`Status:` + repeatedStringForStatus + `,`,
-		`}`,
-	}, "")
-	return s
+	x := &imagesBuildSessionClient{stream}
+	return x, nil
 }
-func (this *ImageStatus) String() string {
-	if this == nil {
-		return "nil"
+
+type Images_BuildSessionClient interface {
+	Send(*control.BytesMessage) error
+	Recv() (*control.BytesMessage, error)
+	grpc.ClientStream
+}
+
+type imagesBuildSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *imagesBuildSessionClient) Send(m *control.BytesMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *imagesBuildSessionClient) Recv() (*control.BytesMessage, error) {
+	m := new(control.BytesMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ImageStatus{`,
-		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
-		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "timestamp.Timestamp", 1), `&`, ``, 1) + `,`,
-		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "timestamp.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return m, nil
 }
-func (this *ImageRemoveRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *imagesClient) Status(ctx context.Context, in *ImageStatusRequest, opts ...grpc.CallOption) (*ImageStatusResponse, error) {
+	out := new(ImageStatusResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ImageRemoveRequest{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ImageRemoveResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *imagesClient) Inspect(ctx context.Context, in *ImageInspectRequest, opts ...grpc.CallOption) (*ImageInspectResponse, error) {
+	out := new(ImageInspectResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Inspect", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ImageRemoveResponse{`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ImageStatusRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *imagesClient) History(ctx context.Context, in *ImageHistoryRequest, opts ...grpc.CallOption) (*ImageHistoryResponse, error) {
+	out := new(ImageHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/History", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ImageStatusRequest{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ImageStatusResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *imagesClient) List(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error) {
+	out := new(ImageListResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/List", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ImageStatusResponse{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "Image", "v1alpha2.Image", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ImageTagRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *imagesClient) Pull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (*ImagePullResponse, error) {
+	out := new(ImagePullResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Pull", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ImageTagRequest{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
-		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
-		`}`,
-	}, "")
-	return s
+	return out, nil
 }
-func (this *ImageTagResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (c *imagesClient) PullProgress(ctx context.Context, in *ImageProgressRequest, opts ...grpc.CallOption) (Images_PullProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Images_serviceDesc.Streams[2], "/kim.services.images.v1alpha1.Images/PullProgress", opts...)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ImageTagResponse{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "Image", "v1alpha2.Image", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	x := &imagesPullProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func valueToStringImages(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+type Images_PullProgressClient interface {
+	Recv() (*ImageProgressResponse, error)
+	grpc.ClientStream
+}
+
+type imagesPullProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *imagesPullProgressClient) Recv() (*ImageProgressResponse, error) {
+	m := new(ImageProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return m, nil
 }
-func (m *ImageListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (c *imagesClient) Push(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (*ImagePushResponse, error) {
+	out := new(ImagePushResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Push", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imagesClient) PushProgress(ctx context.Context, in *ImageProgressRequest, opts ...grpc.CallOption) (Images_PushProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Images_serviceDesc.Streams[3], "/kim.services.images.v1alpha1.Images/PushProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &imagesPushProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Images_PushProgressClient interface {
+	Recv() (*ImageProgressResponse, error)
+	grpc.ClientStream
+}
+
+type imagesPushProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *imagesPushProgressClient) Recv() (*ImageProgressResponse, error) {
+	m := new(ImageProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *imagesClient) Remove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error) {
+	out := new(ImageRemoveResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imagesClient) Prune(ctx context.Context, in *ImagePruneRequest, opts ...grpc.CallOption) (*ImagePruneResponse, error) {
+	out := new(ImagePruneResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Prune", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imagesClient) Tag(ctx context.Context, in *ImageTagRequest, opts ...grpc.CallOption) (*ImageTagResponse, error) {
+	out := new(ImageTagResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Tag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imagesClient) Export(ctx context.Context, in *ImageExportRequest, opts ...grpc.CallOption) (Images_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Images_serviceDesc.Streams[4], "/kim.services.images.v1alpha1.Images/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &imagesExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Images_ExportClient interface {
+	Recv() (*ImageExportResponse, error)
+	grpc.ClientStream
+}
+
+type imagesExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *imagesExportClient) Recv() (*ImageExportResponse, error) {
+	m := new(ImageExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *imagesClient) Import(ctx context.Context, opts ...grpc.CallOption) (Images_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Images_serviceDesc.Streams[5], "/kim.services.images.v1alpha1.Images/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &imagesImportClient{stream}
+	return x, nil
+}
+
+type Images_ImportClient interface {
+	Send(*ImageImportRequest) error
+	CloseAndRecv() (*ImageImportResponse, error)
+	grpc.ClientStream
+}
+
+type imagesImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *imagesImportClient) Send(m *ImageImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *imagesImportClient) CloseAndRecv() (*ImageImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImageImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *imagesClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
+	out := new(InfoResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imagesClient) CacheUsage(ctx context.Context, in *control.DiskUsageRequest, opts ...grpc.CallOption) (*control.DiskUsageResponse, error) {
+	out := new(control.DiskUsageResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/CacheUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imagesClient) CachePrune(ctx context.Context, in *control.PruneRequest, opts ...grpc.CallOption) (Images_CachePruneClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Images_serviceDesc.Streams[6], "/kim.services.images.v1alpha1.Images/CachePrune", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &imagesCachePruneClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Images_CachePruneClient interface {
+	Recv() (*control.UsageRecord, error)
+	grpc.ClientStream
+}
+
+type imagesCachePruneClient struct {
+	grpc.ClientStream
+}
+
+func (x *imagesCachePruneClient) Recv() (*control.UsageRecord, error) {
+	m := new(control.UsageRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *imagesClient) Workers(ctx context.Context, in *control.ListWorkersRequest, opts ...grpc.CallOption) (*control.ListWorkersResponse, error) {
+	out := new(control.ListWorkersResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Images/Workers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImagesServer is the server API for Images service.
+type ImagesServer interface {
+	// Build an image
+	Build(context.Context, *ImageBuildRequest) (*ImageBuildResponse, error)
+	BuildStatus(*ImageBuildStatusRequest, Images_BuildStatusServer) error
+	BuildSession(Images_BuildSessionServer) error
+	// Status of an image
+	Status(context.Context, *ImageStatusRequest) (*ImageStatusResponse, error)
+	// Inspect an image, returning the raw index, manifest and config from the content store
+	Inspect(context.Context, *ImageInspectRequest) (*ImageInspectResponse, error)
+	// History of an image, per layer
+	History(context.Context, *ImageHistoryRequest) (*ImageHistoryResponse, error)
+	// List images
+	List(context.Context, *ImageListRequest) (*ImageListResponse, error)
+	// Pull an image
+	Pull(context.Context, *ImagePullRequest) (*ImagePullResponse, error)
+	PullProgress(*ImageProgressRequest, Images_PullProgressServer) error
+	// Push an image
+	Push(context.Context, *ImagePushRequest) (*ImagePushResponse, error)
+	PushProgress(*ImageProgressRequest, Images_PushProgressServer) error
+	// Remove an image
+	Remove(context.Context, *ImageRemoveRequest) (*ImageRemoveResponse, error)
+	// Prune unused images
+	Prune(context.Context, *ImagePruneRequest) (*ImagePruneResponse, error)
+	// Tag an image
+	Tag(context.Context, *ImageTagRequest) (*ImageTagResponse, error)
+	// Export image(s) as a tarball
+	Export(*ImageExportRequest, Images_ExportServer) error
+	// Import image(s) from a tarball
+	Import(Images_ImportServer) error
+	// Info about the agent and its backends, for diagnostics
+	Info(context.Context, *InfoRequest) (*InfoResponse, error)
+	// Disk usage of the build cache, proxied to buildkitd
+	CacheUsage(context.Context, *control.DiskUsageRequest) (*control.DiskUsageResponse, error)
+	// Prune the build cache, proxied to buildkitd
+	CachePrune(*control.PruneRequest, Images_CachePruneServer) error
+	// Workers of buildkitd, proxied to buildkitd
+	Workers(context.Context, *control.ListWorkersRequest) (*control.ListWorkersResponse, error)
+}
+
+// UnimplementedImagesServer can be embedded to have forward compatible implementations.
+type UnimplementedImagesServer struct {
+}
+
+func (*UnimplementedImagesServer) Build(ctx context.Context, req *ImageBuildRequest) (*ImageBuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Build not implemented")
+}
+func (*UnimplementedImagesServer) BuildStatus(req *ImageBuildStatusRequest, srv Images_BuildStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method BuildStatus not implemented")
+}
+func (*UnimplementedImagesServer) BuildSession(srv Images_BuildSessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method BuildSession not implemented")
+}
+func (*UnimplementedImagesServer) Status(ctx context.Context, req *ImageStatusRequest) (*ImageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedImagesServer) Inspect(ctx context.Context, req *ImageInspectRequest) (*ImageInspectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inspect not implemented")
+}
+func (*UnimplementedImagesServer) History(ctx context.Context, req *ImageHistoryRequest) (*ImageHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (*UnimplementedImagesServer) List(ctx context.Context, req *ImageListRequest) (*ImageListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedImagesServer) Pull(ctx context.Context, req *ImagePullRequest) (*ImagePullResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pull not implemented")
+}
+func (*UnimplementedImagesServer) PullProgress(req *ImageProgressRequest, srv Images_PullProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method PullProgress not implemented")
+}
+func (*UnimplementedImagesServer) Push(ctx context.Context, req *ImagePushRequest) (*ImagePushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Push not implemented")
+}
+func (*UnimplementedImagesServer) PushProgress(req *ImageProgressRequest, srv Images_PushProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushProgress not implemented")
+}
+func (*UnimplementedImagesServer) Remove(ctx context.Context, req *ImageRemoveRequest) (*ImageRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (*UnimplementedImagesServer) Prune(ctx context.Context, req *ImagePruneRequest) (*ImagePruneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prune not implemented")
+}
+func (*UnimplementedImagesServer) Tag(ctx context.Context, req *ImageTagRequest) (*ImageTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tag not implemented")
+}
+func (*UnimplementedImagesServer) Export(req *ImageExportRequest, srv Images_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedImagesServer) Import(srv Images_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedImagesServer) Info(ctx context.Context, req *InfoRequest) (*InfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
+}
+func (*UnimplementedImagesServer) CacheUsage(ctx context.Context, req *control.DiskUsageRequest) (*control.DiskUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CacheUsage not implemented")
+}
+func (*UnimplementedImagesServer) CachePrune(req *control.PruneRequest, srv Images_CachePruneServer) error {
+	return status.Errorf(codes.Unimplemented, "method CachePrune not implemented")
+}
+func (*UnimplementedImagesServer) Workers(ctx context.Context, req *control.ListWorkersRequest) (*control.ListWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Workers not implemented")
+}
+
+func RegisterImagesServer(s *grpc.Server, srv ImagesServer) {
+	s.RegisterService(&_Images_serviceDesc, srv)
+}
+
+func _Images_Build_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Build(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Build",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Build(ctx, req.(*ImageBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_BuildStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageBuildStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImagesServer).BuildStatus(m, &imagesBuildStatusServer{stream})
+}
+
+type Images_BuildStatusServer interface {
+	Send(*ImageBuildStatusResponse) error
+	grpc.ServerStream
+}
+
+type imagesBuildStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *imagesBuildStatusServer) Send(m *ImageBuildStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Images_BuildSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ImagesServer).BuildSession(&imagesBuildSessionServer{stream})
+}
+
+type Images_BuildSessionServer interface {
+	Send(*control.BytesMessage) error
+	Recv() (*control.BytesMessage, error)
+	grpc.ServerStream
+}
+
+type imagesBuildSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *imagesBuildSessionServer) Send(m *control.BytesMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *imagesBuildSessionServer) Recv() (*control.BytesMessage, error) {
+	m := new(control.BytesMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Images_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Status(ctx, req.(*ImageStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageInspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Inspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Inspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Inspect(ctx, req.(*ImageInspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).History(ctx, req.(*ImageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).List(ctx, req.(*ImageListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_Pull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImagePullRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Pull(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Pull",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Pull(ctx, req.(*ImagePullRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_PullProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImagesServer).PullProgress(m, &imagesPullProgressServer{stream})
+}
+
+type Images_PullProgressServer interface {
+	Send(*ImageProgressResponse) error
+	grpc.ServerStream
+}
+
+type imagesPullProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *imagesPullProgressServer) Send(m *ImageProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Images_Push_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImagePushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Push(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Push",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Push(ctx, req.(*ImagePushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_PushProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImagesServer).PushProgress(m, &imagesPushProgressServer{stream})
+}
+
+type Images_PushProgressServer interface {
+	Send(*ImageProgressResponse) error
+	grpc.ServerStream
+}
+
+type imagesPushProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *imagesPushProgressServer) Send(m *ImageProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Images_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Remove(ctx, req.(*ImageRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_Prune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImagePruneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Prune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Prune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Prune(ctx, req.(*ImagePruneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_Tag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Tag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Tag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Tag(ctx, req.(*ImageTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImagesServer).Export(m, &imagesExportServer{stream})
+}
+
+type Images_ExportServer interface {
+	Send(*ImageExportResponse) error
+	grpc.ServerStream
+}
+
+type imagesExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *imagesExportServer) Send(m *ImageExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Images_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ImagesServer).Import(&imagesImportServer{stream})
+}
+
+type Images_ImportServer interface {
+	SendAndClose(*ImageImportResponse) error
+	Recv() (*ImageImportRequest, error)
+	grpc.ServerStream
+}
+
+type imagesImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *imagesImportServer) SendAndClose(m *ImageImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *imagesImportServer) Recv() (*ImageImportRequest, error) {
+	m := new(ImageImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Images_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Info(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Info",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Info(ctx, req.(*InfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_CacheUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(control.DiskUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).CacheUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/CacheUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).CacheUsage(ctx, req.(*control.DiskUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Images_CachePrune_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(control.PruneRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImagesServer).CachePrune(m, &imagesCachePruneServer{stream})
+}
+
+type Images_CachePruneServer interface {
+	Send(*control.UsageRecord) error
+	grpc.ServerStream
+}
+
+type imagesCachePruneServer struct {
+	grpc.ServerStream
+}
+
+func (x *imagesCachePruneServer) Send(m *control.UsageRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Images_Workers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(control.ListWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagesServer).Workers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Images/Workers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagesServer).Workers(ctx, req.(*control.ListWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Images_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kim.services.images.v1alpha1.Images",
+	HandlerType: (*ImagesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Build",
+			Handler:    _Images_Build_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Images_Status_Handler,
+		},
+		{
+			MethodName: "Inspect",
+			Handler:    _Images_Inspect_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Images_History_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Images_List_Handler,
+		},
+		{
+			MethodName: "Pull",
+			Handler:    _Images_Pull_Handler,
+		},
+		{
+			MethodName: "Push",
+			Handler:    _Images_Push_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _Images_Remove_Handler,
+		},
+		{
+			MethodName: "Prune",
+			Handler:    _Images_Prune_Handler,
+		},
+		{
+			MethodName: "Tag",
+			Handler:    _Images_Tag_Handler,
+		},
+		{
+			MethodName: "Info",
+			Handler:    _Images_Info_Handler,
+		},
+		{
+			MethodName: "CacheUsage",
+			Handler:    _Images_CacheUsage_Handler,
+		},
+		{
+			MethodName: "Workers",
+			Handler:    _Images_Workers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BuildStatus",
+			Handler:       _Images_BuildStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BuildSession",
+			Handler:       _Images_BuildSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PullProgress",
+			Handler:       _Images_PullProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PushProgress",
+			Handler:       _Images_PushProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _Images_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Images_Import_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CachePrune",
+			Handler:       _Images_CachePrune_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/apis/services/images/v1alpha1/images.proto",
+}
+
+// JobsClient is the client API for Jobs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type JobsClient interface {
+	// List the running, and recently finished, builds, pulls and pushes
+	List(ctx context.Context, in *JobListRequest, opts ...grpc.CallOption) (*JobListResponse, error)
+	// Get a job
+	Get(ctx context.Context, in *JobGetRequest, opts ...grpc.CallOption) (*JobGetResponse, error)
+	// Watch the progress of a job until it is finished
+	Watch(ctx context.Context, in *JobWatchRequest, opts ...grpc.CallOption) (Jobs_WatchClient, error)
+	// Cancel a running job
+	Cancel(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*JobCancelResponse, error)
+}
+
+type jobsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewJobsClient(cc *grpc.ClientConn) JobsClient {
+	return &jobsClient{cc}
+}
+
+func (c *jobsClient) List(ctx context.Context, in *JobListRequest, opts ...grpc.CallOption) (*JobListResponse, error) {
+	out := new(JobListResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Jobs/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobsClient) Get(ctx context.Context, in *JobGetRequest, opts ...grpc.CallOption) (*JobGetResponse, error) {
+	out := new(JobGetResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Jobs/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobsClient) Watch(ctx context.Context, in *JobWatchRequest, opts ...grpc.CallOption) (Jobs_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Jobs_serviceDesc.Streams[0], "/kim.services.images.v1alpha1.Jobs/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobsWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Jobs_WatchClient interface {
+	Recv() (*JobWatchResponse, error)
+	grpc.ClientStream
+}
+
+type jobsWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobsWatchClient) Recv() (*JobWatchResponse, error) {
+	m := new(JobWatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobsClient) Cancel(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*JobCancelResponse, error) {
+	out := new(JobCancelResponse)
+	err := c.cc.Invoke(ctx, "/kim.services.images.v1alpha1.Jobs/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobsServer is the server API for Jobs service.
+type JobsServer interface {
+	// List the running, and recently finished, builds, pulls and pushes
+	List(context.Context, *JobListRequest) (*JobListResponse, error)
+	// Get a job
+	Get(context.Context, *JobGetRequest) (*JobGetResponse, error)
+	// Watch the progress of a job until it is finished
+	Watch(*JobWatchRequest, Jobs_WatchServer) error
+	// Cancel a running job
+	Cancel(context.Context, *JobCancelRequest) (*JobCancelResponse, error)
+}
+
+// UnimplementedJobsServer can be embedded to have forward compatible implementations.
+type UnimplementedJobsServer struct {
+}
+
+func (*UnimplementedJobsServer) List(ctx context.Context, req *JobListRequest) (*JobListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedJobsServer) Get(ctx context.Context, req *JobGetRequest) (*JobGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedJobsServer) Watch(req *JobWatchRequest, srv Jobs_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedJobsServer) Cancel(ctx context.Context, req *JobCancelRequest) (*JobCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+
+func RegisterJobsServer(s *grpc.Server, srv JobsServer) {
+	s.RegisterService(&_Jobs_serviceDesc, srv)
+}
+
+func _Jobs_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Jobs/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServer).List(ctx, req.(*JobListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jobs_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Jobs/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServer).Get(ctx, req.(*JobGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jobs_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobsServer).Watch(m, &jobsWatchServer{stream})
+}
+
+type Jobs_WatchServer interface {
+	Send(*JobWatchResponse) error
+	grpc.ServerStream
+}
+
+type jobsWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobsWatchServer) Send(m *JobWatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Jobs_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kim.services.images.v1alpha1.Jobs/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServer).Cancel(ctx, req.(*JobCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Jobs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kim.services.images.v1alpha1.Jobs",
+	HandlerType: (*JobsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Jobs_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Jobs_Get_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Jobs_Cancel_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Jobs_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/apis/services/images/v1alpha1/images.proto",
+}
+
+func (m *ImageBuildRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageBuildRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageBuildRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Squash {
+		i--
+		if m.Squash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FrontendInputs) > 0 {
+		for k := range m.FrontendInputs {
+			v := m.FrontendInputs[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintImages(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintImages(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintImages(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Entitlements) > 0 {
+		for iNdEx := len(m.Entitlements) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Entitlements[iNdEx])
+			copy(dAtA[i:], m.Entitlements[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.Entitlements[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Cache.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintImages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.FrontendAttrs) > 0 {
+		for k := range m.FrontendAttrs {
+			v := m.FrontendAttrs[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintImages(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintImages(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintImages(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Frontend) > 0 {
+		i -= len(m.Frontend)
+		copy(dAtA[i:], m.Frontend)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Frontend)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Session) > 0 {
+		i -= len(m.Session)
+		copy(dAtA[i:], m.Session)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Session)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExporterAttrs) > 0 {
+		for k := range m.ExporterAttrs {
+			v := m.ExporterAttrs[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintImages(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintImages(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintImages(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Exporter) > 0 {
+		i -= len(m.Exporter)
+		copy(dAtA[i:], m.Exporter)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Exporter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Definition != nil {
+		{
+			size, err := m.Definition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ref) > 0 {
+		i -= len(m.Ref)
+		copy(dAtA[i:], m.Ref)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Ref)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageBuildResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageBuildResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageBuildResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExporterResponse) > 0 {
+		for k := range m.ExporterResponse {
+			v := m.ExporterResponse[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintImages(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintImages(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintImages(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageBuildStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageBuildStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageBuildStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ref) > 0 {
+		i -= len(m.Ref)
+		copy(dAtA[i:], m.Ref)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Ref)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageBuildStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageBuildStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageBuildStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Vertexes) > 0 {
+		for iNdEx := len(m.Vertexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vertexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.References) > 0 {
+		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.References[iNdEx])
+			copy(dAtA[i:], m.References[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.References[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Filters[iNdEx])
+			copy(dAtA[i:], m.Filters[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.Filters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintImages(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintImages(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintImages(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Platforms) > 0 {
+		for iNdEx := len(m.Platforms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Platforms[iNdEx])
+			copy(dAtA[i:], m.Platforms[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.Platforms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintImages(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintImages(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintImages(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintImages(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ImagePullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImagePullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImagePullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImagePullResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImagePullResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImagePullResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImagePushRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImagePushRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImagePushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImagePushResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImagePushResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImagePushResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		for iNdEx := len(m.Status) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Status[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintImages(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintImages(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	if m.Total != 0 {
+		i = encodeVarintImages(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Offset != 0 {
+		i = encodeVarintImages(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ref) > 0 {
+		i -= len(m.Ref)
+		copy(dAtA[i:], m.Ref)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Ref)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageRemoveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageRemoveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageRemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageRemoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageRemoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageRemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageRemoveResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageRemoveResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageRemoveResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Code != 0 {
+		i = encodeVarintImages(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Deleted) > 0 {
+		i -= len(m.Deleted)
+		copy(dAtA[i:], m.Deleted)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Deleted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Untagged) > 0 {
+		for iNdEx := len(m.Untagged) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Untagged[iNdEx])
+			copy(dAtA[i:], m.Untagged[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.Untagged[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImagePruneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImagePruneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImagePruneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Filters[iNdEx])
+			copy(dAtA[i:], m.Filters[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.Filters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImagePruneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImagePruneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImagePruneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpaceReclaimed != 0 {
+		i = encodeVarintImages(dAtA, i, uint64(m.SpaceReclaimed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImagePruned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImagePruned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImagePruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refs) > 0 {
+		for iNdEx := len(m.Refs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Refs[iNdEx])
+			copy(dAtA[i:], m.Refs[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.Refs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageInspectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageInspectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageInspectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageInspectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageInspectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageInspectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Platforms) > 0 {
+		for iNdEx := len(m.Platforms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Platforms[iNdEx])
+			copy(dAtA[i:], m.Platforms[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.Platforms[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Manifest) > 0 {
+		i -= len(m.Manifest)
+		copy(dAtA[i:], m.Manifest)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Manifest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MediaType) > 0 {
+		i -= len(m.MediaType)
+		copy(dAtA[i:], m.MediaType)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.MediaType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintImages(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Layer) > 0 {
+		i -= len(m.Layer)
+		copy(dAtA[i:], m.Layer)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Layer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EmptyLayer {
+		i--
+		if m.EmptyLayer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintImages(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BuildkitSocket) > 0 {
+		i -= len(m.BuildkitSocket)
+		copy(dAtA[i:], m.BuildkitSocket)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.BuildkitSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ContainerdVersion) > 0 {
+		i -= len(m.ContainerdVersion)
+		copy(dAtA[i:], m.ContainerdVersion)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.ContainerdVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ContainerdSocket) > 0 {
+		i -= len(m.ContainerdSocket)
+		copy(dAtA[i:], m.ContainerdSocket)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.ContainerdSocket)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GitCommit) > 0 {
+		i -= len(m.GitCommit)
+		copy(dAtA[i:], m.GitCommit)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.GitCommit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Available != 0 {
+		i = encodeVarintImages(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Used != 0 {
+		i = encodeVarintImages(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Total != 0 {
+		i = encodeVarintImages(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllPlatforms {
+		i--
+		if m.AllPlatforms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageImportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageImportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageImportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageImportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageImportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageImportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Images[iNdEx])
+			copy(dAtA[i:], m.Images[iNdEx])
+			i = encodeVarintImages(dAtA, i, uint64(len(m.Images[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Job) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.FinishedAt != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FinishedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedAt):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintImages(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintImages(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobGetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobGetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobGetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobWatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobWatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobWatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobWatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobWatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobWatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Build != nil {
+		{
+			size, err := m.Build.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Progress) > 0 {
+		for iNdEx := len(m.Progress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Progress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintImages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintImages(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintImages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintImages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovImages(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ImageBuildRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if m.Definition != nil {
+		l = m.Definition.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Exporter)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.ExporterAttrs) > 0 {
+		for k, v := range m.ExporterAttrs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovImages(uint64(len(k))) + 1 + len(v) + sovImages(uint64(len(v)))
+			n += mapEntrySize + 1 + sovImages(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Session)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Frontend)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.FrontendAttrs) > 0 {
+		for k, v := range m.FrontendAttrs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovImages(uint64(len(k))) + 1 + len(v) + sovImages(uint64(len(v)))
+			n += mapEntrySize + 1 + sovImages(uint64(mapEntrySize))
+		}
+	}
+	l = m.Cache.Size()
+	n += 1 + l + sovImages(uint64(l))
+	if len(m.Entitlements) > 0 {
+		for _, s := range m.Entitlements {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	if len(m.FrontendInputs) > 0 {
+		for k, v := range m.FrontendInputs {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovImages(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovImages(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovImages(uint64(mapEntrySize))
+		}
+	}
+	if m.Squash {
+		n += 2
+	}
+	return n
+}
+
+func (m *ImageBuildResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExporterResponse) > 0 {
+		for k, v := range m.ExporterResponse {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovImages(uint64(len(k))) + 1 + len(v) + sovImages(uint64(len(v)))
+			n += mapEntrySize + 1 + sovImages(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ImageBuildStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageBuildStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vertexes) > 0 {
+		for _, e := range m.Vertexes {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImageListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, s := range m.Filters {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	if len(m.References) > 0 {
+		for _, s := range m.References {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImageListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovImages(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovImages(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovImages(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ImageMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovImages(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovImages(uint64(len(k))) + 1 + len(v) + sovImages(uint64(len(v)))
+			n += mapEntrySize + 1 + sovImages(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Platforms) > 0 {
+		for _, s := range m.Platforms {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImagePullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImagePullResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImagePushRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImagePushResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		for _, e := range m.Status {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImageStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovImages(uint64(m.Offset))
+	}
+	if m.Total != 0 {
+		n += 1 + sovImages(uint64(m.Total))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovImages(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovImages(uint64(l))
+	return n
+}
+
+func (m *ImageRemoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *ImageRemoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImageRemoveResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.Untagged) > 0 {
+		for _, s := range m.Untagged {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	l = len(m.Deleted)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovImages(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImagePruneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.All {
+		n += 2
+	}
+	if len(m.Filters) > 0 {
+		for _, s := range m.Filters {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *ImagePruneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	if m.SpaceReclaimed != 0 {
+		n += 1 + sovImages(uint64(m.SpaceReclaimed))
+	}
+	return n
+}
+
+func (m *ImagePruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.Refs) > 0 {
+		for _, s := range m.Refs {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImageStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageInspectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageInspectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.MediaType)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Manifest)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.Platforms) > 0 {
+		for _, s := range m.Platforms {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImageHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImageHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovImages(uint64(l))
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if m.EmptyLayer {
+		n += 2
+	}
+	l = len(m.Layer)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovImages(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *InfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.GitCommit)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.ContainerdSocket)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.ContainerdVersion)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.BuildkitSocket)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VolumeUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovImages(uint64(m.Total))
+	}
+	if m.Used != 0 {
+		n += 1 + sovImages(uint64(m.Used))
+	}
+	if m.Available != 0 {
+		n += 1 + sovImages(uint64(m.Available))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImageTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if m.AllPlatforms {
+		n += 2
+	}
+	return n
+}
+
+func (m *ImageExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageImportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *ImageImportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for _, s := range m.Images {
+			l = len(s)
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Job) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovImages(uint64(l))
+	if m.FinishedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedAt)
+		n += 1 + l + sovImages(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *JobListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.All {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *JobGetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *JobWatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *JobWatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	if len(m.Progress) > 0 {
+		for _, e := range m.Progress {
+			l = e.Size()
+			n += 1 + l + sovImages(uint64(l))
+		}
+	}
+	if m.Build != nil {
+		l = m.Build.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovImages(uint64(l))
+	}
+	return n
+}
+
+func sovImages(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozImages(x uint64) (n int) {
+	return sovImages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ImageBuildRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForExporterAttrs := make([]string, 0, len(this.ExporterAttrs))
+	for k, _ := range this.ExporterAttrs {
+		keysForExporterAttrs = append(keysForExporterAttrs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExporterAttrs)
+	mapStringForExporterAttrs := "map[string]string{"
+	for _, k := range keysForExporterAttrs {
+		mapStringForExporterAttrs += fmt.Sprintf("%v: %v,", k, this.ExporterAttrs[k])
+	}
+	mapStringForExporterAttrs += "}"
+	keysForFrontendAttrs := make([]string, 0, len(this.FrontendAttrs))
+	for k, _ := range this.FrontendAttrs {
+		keysForFrontendAttrs = append(keysForFrontendAttrs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFrontendAttrs)
+	mapStringForFrontendAttrs := "map[string]string{"
+	for _, k := range keysForFrontendAttrs {
+		mapStringForFrontendAttrs += fmt.Sprintf("%v: %v,", k, this.FrontendAttrs[k])
+	}
+	mapStringForFrontendAttrs += "}"
+	keysForFrontendInputs := make([]string, 0, len(this.FrontendInputs))
+	for k, _ := range this.FrontendInputs {
+		keysForFrontendInputs = append(keysForFrontendInputs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFrontendInputs)
+	mapStringForFrontendInputs := "map[string]*pb.Definition{"
+	for _, k := range keysForFrontendInputs {
+		mapStringForFrontendInputs += fmt.Sprintf("%v: %v,", k, this.FrontendInputs[k])
+	}
+	mapStringForFrontendInputs += "}"
+	s := strings.Join([]string{`&ImageBuildRequest{`,
+		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
+		`Definition:` + strings.Replace(fmt.Sprintf("%v", this.Definition), "Definition", "pb.Definition", 1) + `,`,
+		`Exporter:` + fmt.Sprintf("%v", this.Exporter) + `,`,
+		`ExporterAttrs:` + mapStringForExporterAttrs + `,`,
+		`Session:` + fmt.Sprintf("%v", this.Session) + `,`,
+		`Frontend:` + fmt.Sprintf("%v", this.Frontend) + `,`,
+		`FrontendAttrs:` + mapStringForFrontendAttrs + `,`,
+		`Cache:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Cache), "CacheOptions", "control.CacheOptions", 1), `&`, ``, 1) + `,`,
+		`Entitlements:` + fmt.Sprintf("%v", this.Entitlements) + `,`,
+		`FrontendInputs:` + mapStringForFrontendInputs + `,`,
+		`Squash:` + fmt.Sprintf("%v", this.Squash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageBuildResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForExporterResponse := make([]string, 0, len(this.ExporterResponse))
+	for k, _ := range this.ExporterResponse {
+		keysForExporterResponse = append(keysForExporterResponse, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExporterResponse)
+	mapStringForExporterResponse := "map[string]string{"
+	for _, k := range keysForExporterResponse {
+		mapStringForExporterResponse += fmt.Sprintf("%v: %v,", k, this.ExporterResponse[k])
+	}
+	mapStringForExporterResponse += "}"
+	s := strings.Join([]string{`&ImageBuildResponse{`,
+		`ExporterResponse:` + mapStringForExporterResponse + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageBuildStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageBuildStatusRequest{`,
+		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageBuildStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVertexes := "[]*Vertex{"
+	for _, f := range this.Vertexes {
+		repeatedStringForVertexes += strings.Replace(fmt.Sprintf("%v", f), "Vertex", "control.Vertex", 1) + ","
+	}
+	repeatedStringForVertexes += "}"
+	repeatedStringForStatuses := "[]*VertexStatus{"
+	for _, f := range this.Statuses {
+		repeatedStringForStatuses += strings.Replace(fmt.Sprintf("%v", f), "VertexStatus", "control.VertexStatus", 1) + ","
+	}
+	repeatedStringForStatuses += "}"
+	repeatedStringForLogs := "[]*VertexLog{"
+	for _, f := range this.Logs {
+		repeatedStringForLogs += strings.Replace(fmt.Sprintf("%v", f), "VertexLog", "control.VertexLog", 1) + ","
+	}
+	repeatedStringForLogs += "}"
+	s := strings.Join([]string{`&ImageBuildStatusResponse{`,
+		`Vertexes:` + repeatedStringForVertexes + `,`,
+		`Statuses:` + repeatedStringForStatuses + `,`,
+		`Logs:` + repeatedStringForLogs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageListRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageListRequest{`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ImageFilter", "v1alpha2.ImageFilter", 1) + `,`,
+		`Filters:` + fmt.Sprintf("%v", this.Filters) + `,`,
+		`References:` + fmt.Sprintf("%v", this.References) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageListResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImages := "[]*Image{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(fmt.Sprintf("%v", f), "Image", "v1alpha2.Image", 1) + ","
+	}
+	repeatedStringForImages += "}"
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k, _ := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]*ImageMetadata{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&ImageListResponse{`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&ImageMetadata{`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "timestamp.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Platforms:` + fmt.Sprintf("%v", this.Platforms) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImagePullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImagePullRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
+		`Auth:` + strings.Replace(fmt.Sprintf("%v", this.Auth), "AuthConfig", "v1alpha2.AuthConfig", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImagePullResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImagePullResponse{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImagePushRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImagePushRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
+		`Auth:` + strings.Replace(fmt.Sprintf("%v", this.Auth), "AuthConfig", "v1alpha2.AuthConfig", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImagePushResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImagePushResponse{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageProgressRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageProgressRequest{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageProgressResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForStatus := "[]ImageStatus{"
+	for _, f := range this.Status {
+		repeatedStringForStatus += strings.Replace(strings.Replace(f.String(), "ImageStatus", "ImageStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStatus += "}"
+	s := strings.Join([]string{`&ImageProgressResponse{`,
+		`Status:` + repeatedStringForStatus + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageStatus{`,
+		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "timestamp.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "timestamp.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageRemoveRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImages := "[]*ImageSpec{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(fmt.Sprintf("%v", f), "ImageSpec", "v1alpha2.ImageSpec", 1) + ","
+	}
+	repeatedStringForImages += "}"
+	s := strings.Join([]string{`&ImageRemoveRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageRemoveResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResults := "[]*ImageRemoveResult{"
+	for _, f := range this.Results {
+		repeatedStringForResults += strings.Replace(f.String(), "ImageRemoveResult", "ImageRemoveResult", 1) + ","
+	}
+	repeatedStringForResults += "}"
+	s := strings.Join([]string{`&ImageRemoveResponse{`,
+		`Results:` + repeatedStringForResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageRemoveResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageRemoveResult{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
+		`Untagged:` + fmt.Sprintf("%v", this.Untagged) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImagePruneRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImagePruneRequest{`,
+		`All:` + fmt.Sprintf("%v", this.All) + `,`,
+		`Filters:` + fmt.Sprintf("%v", this.Filters) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImagePruneResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImages := "[]*ImagePruned{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(f.String(), "ImagePruned", "ImagePruned", 1) + ","
+	}
+	repeatedStringForImages += "}"
+	s := strings.Join([]string{`&ImagePruneResponse{`,
+		`Images:` + repeatedStringForImages + `,`,
+		`SpaceReclaimed:` + fmt.Sprintf("%v", this.SpaceReclaimed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImagePruned) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImagePruned{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Refs:` + fmt.Sprintf("%v", this.Refs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageStatusRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageStatusResponse{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "Image", "v1alpha2.Image", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageInspectRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageInspectRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageInspectResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageInspectResponse{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "Image", "v1alpha2.Image", 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`MediaType:` + fmt.Sprintf("%v", this.MediaType) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Manifest:` + fmt.Sprintf("%v", this.Manifest) + `,`,
+		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
+		`Platforms:` + fmt.Sprintf("%v", this.Platforms) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageHistoryRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageHistoryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistory := "[]ImageHistory{"
+	for _, f := range this.History {
+		repeatedStringForHistory += strings.Replace(strings.Replace(f.String(), "ImageHistory", "ImageHistory", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHistory += "}"
+	s := strings.Join([]string{`&ImageHistoryResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`History:` + repeatedStringForHistory + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageHistory{`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "timestamp.Timestamp", 1), `&`, ``, 1) + `,`,
+		`CreatedBy:` + fmt.Sprintf("%v", this.CreatedBy) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Comment:` + fmt.Sprintf("%v", this.Comment) + `,`,
+		`EmptyLayer:` + fmt.Sprintf("%v", this.EmptyLayer) + `,`,
+		`Layer:` + fmt.Sprintf("%v", this.Layer) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InfoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InfoRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InfoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVolumes := "[]*VolumeUsage{"
+	for _, f := range this.Volumes {
+		repeatedStringForVolumes += strings.Replace(f.String(), "VolumeUsage", "VolumeUsage", 1) + ","
+	}
+	repeatedStringForVolumes += "}"
+	s := strings.Join([]string{`&InfoResponse{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`GitCommit:` + fmt.Sprintf("%v", this.GitCommit) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`ContainerdSocket:` + fmt.Sprintf("%v", this.ContainerdSocket) + `,`,
+		`ContainerdVersion:` + fmt.Sprintf("%v", this.ContainerdVersion) + `,`,
+		`BuildkitSocket:` + fmt.Sprintf("%v", this.BuildkitSocket) + `,`,
+		`Volumes:` + repeatedStringForVolumes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VolumeUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VolumeUsage{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Used:` + fmt.Sprintf("%v", this.Used) + `,`,
+		`Available:` + fmt.Sprintf("%v", this.Available) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageTagRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageTagRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "v1alpha2.ImageSpec", 1) + `,`,
+		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageTagResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageTagResponse{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "Image", "v1alpha2.Image", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageExportRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImages := "[]*ImageSpec{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(fmt.Sprintf("%v", f), "ImageSpec", "v1alpha2.ImageSpec", 1) + ","
+	}
+	repeatedStringForImages += "}"
+	s := strings.Join([]string{`&ImageExportRequest{`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`AllPlatforms:` + fmt.Sprintf("%v", this.AllPlatforms) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageExportResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageExportResponse{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageImportRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageImportRequest{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageImportResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageImportResponse{`,
+		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Job) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Job{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "timestamp.Timestamp", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Timestamp", "timestamp.Timestamp", 1) + `,`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobListRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobListRequest{`,
+		`All:` + fmt.Sprintf("%v", this.All) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobListResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobs := "[]*Job{"
+	for _, f := range this.Jobs {
+		repeatedStringForJobs += strings.Replace(f.String(), "Job", "Job", 1) + ","
+	}
+	repeatedStringForJobs += "}"
+	s := strings.Join([]string{`&JobListResponse{`,
+		`Jobs:` + repeatedStringForJobs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobGetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobGetRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobGetResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobGetResponse{`,
+		`Job:` + strings.Replace(this.Job.String(), "Job", "Job", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobWatchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobWatchRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobWatchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForProgress := "[]ImageStatus{"
+	for _, f := range this.Progress {
+		repeatedStringForProgress += strings.Replace(strings.Replace(f.String(), "ImageStatus", "ImageStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForProgress += "}"
+	s := strings.Join([]string{`&JobWatchResponse{`,
+		`Job:` + strings.Replace(this.Job.String(), "Job", "Job", 1) + `,`,
+		`Progress:` + repeatedStringForProgress + `,`,
+		`Build:` + strings.Replace(this.Build.String(), "ImageBuildStatusResponse", "ImageBuildStatusResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelResponse{`,
+		`Job:` + strings.Replace(this.Job.String(), "Job", "Job", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringImages(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ImageBuildRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageBuildRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageBuildRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Definition == nil {
+				m.Definition = &pb.Definition{}
+			}
+			if err := m.Definition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExporterAttrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExporterAttrs == nil {
+				m.ExporterAttrs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowImages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipImages(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthImages
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExporterAttrs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Session = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frontend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frontend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrontendAttrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FrontendAttrs == nil {
+				m.FrontendAttrs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowImages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipImages(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthImages
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FrontendAttrs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entitlements", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entitlements = append(m.Entitlements, github_com_moby_buildkit_util_entitlements.Entitlement(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrontendInputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FrontendInputs == nil {
+				m.FrontendInputs = make(map[string]*pb.Definition)
+			}
+			var mapkey string
+			var mapvalue *pb.Definition
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowImages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthImages
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &pb.Definition{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipImages(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthImages
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FrontendInputs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Squash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Squash = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageBuildResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageBuildResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageBuildResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExporterResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExporterResponse == nil {
+				m.ExporterResponse = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowImages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipImages(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthImages
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExporterResponse[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageBuildStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageBuildStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageBuildStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageBuildStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageBuildStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageBuildStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vertexes = append(m.Vertexes, &control.Vertex{})
+			if err := m.Vertexes[len(m.Vertexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &control.VertexStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &control.VertexLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &v1alpha2.ImageFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, &v1alpha2.Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]*ImageMetadata)
+			}
+			var mapkey string
+			var mapvalue *ImageMetadata
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowImages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthImages
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ImageMetadata{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipImages(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthImages
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowImages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowImages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthImages
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthImages
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipImages(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthImages
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platforms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platforms = append(m.Platforms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImagePullRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImagePullRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImagePullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &v1alpha2.ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &v1alpha2.AuthConfig{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImagePullResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImagePullResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImagePullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImagePushRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImagePushRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImagePushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &v1alpha2.ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &v1alpha2.AuthConfig{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImagePushResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImagePushResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImagePushResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = append(m.Status, ImageStatus{})
+			if err := m.Status[len(m.Status)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageRemoveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageRemoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &v1alpha2.ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, &v1alpha2.ImageSpec{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageRemoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageRemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &ImageRemoveResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageRemoveResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageRemoveResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageRemoveResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &v1alpha2.ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Untagged", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Untagged = append(m.Untagged, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deleted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImagePruneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImagePruneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImagePruneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImagePruneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImagePruneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImagePruneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, &ImagePruned{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceReclaimed", wireType)
+			}
+			m.SpaceReclaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceReclaimed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImagePruned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImagePruned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImagePruned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refs = append(m.Refs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &v1alpha2.ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &v1alpha2.Image{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageInspectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageInspectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageInspectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &v1alpha2.ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageInspectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageInspectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageInspectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &v1alpha2.Image{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index[:0], dAtA[iNdEx:postIndex]...)
+			if m.Index == nil {
+				m.Index = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifest = append(m.Manifest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Manifest == nil {
+				m.Manifest = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platforms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platforms = append(m.Platforms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &v1alpha2.ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ImageHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyLayer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmptyLayer = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Layer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowImages
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerdSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ContainerdSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerdVersion", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ImageListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerdVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildkitSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildkitSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2279,10 +12858,8 @@ func (m *ImageListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &v1alpha2.ImageFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volumes = append(m.Volumes, &VolumeUsage{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2307,7 +12884,7 @@ func (m *ImageListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageListResponse) Unmarshal(dAtA []byte) error {
+func (m *VolumeUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2330,17 +12907,17 @@ func (m *ImageListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -2350,25 +12927,144 @@ func (m *ImageListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthImages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthImages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, &v1alpha2.Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			m.Available = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Available |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2391,7 +13087,7 @@ func (m *ImageListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImagePullRequest) Unmarshal(dAtA []byte) error {
+func (m *ImageTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2414,10 +13110,10 @@ func (m *ImagePullRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImagePullRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImagePullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2458,9 +13154,9 @@ func (m *ImagePullRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -2470,27 +13166,23 @@ func (m *ImagePullRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthImages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthImages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &v1alpha2.AuthConfig{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2513,7 +13205,7 @@ func (m *ImagePullRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImagePullResponse) Unmarshal(dAtA []byte) error {
+func (m *ImageTagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2536,17 +13228,17 @@ func (m *ImagePullResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImagePullResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageTagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImagePullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -2556,23 +13248,27 @@ func (m *ImagePullResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthImages
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthImages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.Image == nil {
+				m.Image = &v1alpha2.Image{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2595,7 +13291,7 @@ func (m *ImagePullResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImagePushRequest) Unmarshal(dAtA []byte) error {
+func (m *ImageExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2618,15 +13314,15 @@ func (m *ImagePushRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImagePushRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImagePushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2653,18 +13349,16 @@ func (m *ImagePushRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &v1alpha2.ImageSpec{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Images = append(m.Images, &v1alpha2.ImageSpec{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -2674,28 +13368,44 @@ func (m *ImagePushRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthImages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthImages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &v1alpha2.AuthConfig{}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllPlatforms", wireType)
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.AllPlatforms = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipImages(dAtA[iNdEx:])
@@ -2717,7 +13427,7 @@ func (m *ImagePushRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImagePushResponse) Unmarshal(dAtA []byte) error {
+func (m *ImageExportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2740,17 +13450,17 @@ func (m *ImagePushResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImagePushResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageExportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImagePushResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -2760,23 +13470,25 @@ func (m *ImagePushResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthImages
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthImages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2799,7 +13511,7 @@ func (m *ImagePushResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageProgressRequest) Unmarshal(dAtA []byte) error {
+func (m *ImageImportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2822,17 +13534,17 @@ func (m *ImageProgressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageProgressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageImportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -2842,23 +13554,25 @@ func (m *ImageProgressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthImages
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthImages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2881,7 +13595,7 @@ func (m *ImageProgressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageProgressResponse) Unmarshal(dAtA []byte) error {
+func (m *ImageImportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2904,17 +13618,17 @@ func (m *ImageProgressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageProgressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageImportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -2924,25 +13638,23 @@ func (m *ImageProgressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthImages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthImages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = append(m.Status, ImageStatus{})
-			if err := m.Status[len(m.Status)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2965,7 +13677,7 @@ func (m *ImageProgressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageStatus) Unmarshal(dAtA []byte) error {
+func (m *Job) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2988,15 +13700,15 @@ func (m *ImageStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3024,11 +13736,11 @@ func (m *ImageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ref = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3056,13 +13768,13 @@ func (m *ImageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			m.Offset = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -3072,16 +13784,29 @@ func (m *ImageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.Total = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -3091,14 +13816,92 @@ func (m *ImageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3118,22 +13921,107 @@ func (m *ImageStatus) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthImages
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FinishedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthImages
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -3143,25 +14031,12 @@ func (m *ImageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthImages
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthImages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipImages(dAtA[iNdEx:])
@@ -3183,7 +14058,7 @@ func (m *ImageStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageRemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *JobListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3206,15 +14081,15 @@ func (m *ImageRemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageRemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3241,10 +14116,8 @@ func (m *ImageRemoveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &v1alpha2.ImageSpec{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Jobs = append(m.Jobs, &Job{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3269,7 +14142,7 @@ func (m *ImageRemoveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageRemoveResponse) Unmarshal(dAtA []byte) error {
+func (m *JobGetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3292,12 +14165,44 @@ func (m *ImageRemoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageRemoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobGetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipImages(dAtA[iNdEx:])
@@ -3319,7 +14224,7 @@ func (m *ImageRemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *JobGetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3342,15 +14247,15 @@ func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobGetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3377,10 +14282,10 @@ func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &v1alpha2.ImageSpec{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3405,7 +14310,7 @@ func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *JobWatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3428,17 +14333,17 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobWatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobWatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowImages
@@ -3448,27 +14353,23 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthImages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthImages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &v1alpha2.Image{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3491,7 +14392,7 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageTagRequest) Unmarshal(dAtA []byte) error {
+func (m *JobWatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3514,15 +14415,15 @@ func (m *ImageTagRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageTagRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobWatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobWatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3549,16 +14450,136 @@ func (m *ImageTagRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &v1alpha2.ImageSpec{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = append(m.Progress, ImageStatus{})
+			if err := m.Progress[len(m.Progress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Build", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowImages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthImages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthImages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Build == nil {
+				m.Build = &ImageBuildStatusResponse{}
+			}
+			if err := m.Build.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipImages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthImages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowImages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3586,7 +14607,7 @@ func (m *ImageTagRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3609,7 +14630,7 @@ func (m *ImageTagRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageTagResponse) Unmarshal(dAtA []byte) error {
+func (m *JobCancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3632,15 +14653,15 @@ func (m *ImageTagResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageTagResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3667,10 +14688,10 @@ func (m *ImageTagResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &v1alpha2.Image{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
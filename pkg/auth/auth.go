@@ -9,42 +9,87 @@ import (
 	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
 )
 
-// Parse parses AuthConfig and returns username and password/secret required by containerd.
-func Parse(auth *criv1.AuthConfig, host string) (string, string, error) {
+// the username that docker credential helpers return along with an identity token as the secret
+const identityTokenUsername = "<token>"
+
+// Credentials for a registry, at most one of the password, identity token or registry token is set.
+type Credentials struct {
+	Username string
+	Password string
+	// IdentityToken is exchanged for a registry token via the OAuth2 refresh token grant
+	IdentityToken string
+	// RegistryToken is a bearer token to authenticate with as is
+	RegistryToken string
+}
+
+// Empty returns true for anonymous credentials.
+func (c Credentials) Empty() bool {
+	return c.Username == "" && c.Password == "" && c.IdentityToken == "" && c.RegistryToken == ""
+}
+
+// ParseCredentials parses AuthConfig and returns the credentials for the host, or none if the server address of the
+// AuthConfig does not match the host.
+func ParseCredentials(auth *criv1.AuthConfig, host string) (Credentials, error) {
 	if auth == nil {
-		return "", "", nil
+		return Credentials{}, nil
 	}
 	if auth.ServerAddress != "" {
 		// Do not return the auth info when server address doesn't match.
-		u, err := url.Parse(auth.ServerAddress)
+		server, err := NormalizeHost(auth.ServerAddress)
 		if err != nil {
-			return "", "", errors.Wrap(err, "parse server address")
+			return Credentials{}, errors.Wrap(err, "parse server address")
 		}
-		if host != u.Host {
-			return "", "", nil
+		if target, _ := NormalizeHost(host); server != target {
+			return Credentials{}, nil
 		}
 	}
-	if auth.Username != "" {
-		return auth.Username, auth.Password, nil
+	if auth.RegistryToken != "" {
+		return Credentials{RegistryToken: auth.RegistryToken}, nil
 	}
 	if auth.IdentityToken != "" {
-		return "", auth.IdentityToken, nil
+		return Credentials{IdentityToken: auth.IdentityToken}, nil
 	}
-	if auth.Auth != "" {
+	username, password := auth.Username, auth.Password
+	if username == "" && auth.Auth != "" {
 		decLen := base64.StdEncoding.DecodedLen(len(auth.Auth))
 		decoded := make([]byte, decLen)
 		_, err := base64.StdEncoding.Decode(decoded, []byte(auth.Auth))
 		if err != nil {
-			return "", "", err
+			return Credentials{}, err
 		}
 		fields := strings.SplitN(string(decoded), ":", 2)
 		if len(fields) != 2 {
-			return "", "", errors.Errorf("invalid decoded auth: %q", decoded)
+			return Credentials{}, errors.Errorf("invalid decoded auth: %q", decoded)
 		}
-		user, passwd := fields[0], fields[1]
-		return user, strings.Trim(passwd, "\x00"), nil
+		username, password = fields[0], strings.Trim(fields[1], "\x00")
+	}
+	if username == identityTokenUsername {
+		return Credentials{IdentityToken: password}, nil
 	}
-	// TODO(random-liu): Support RegistryToken.
 	// An empty auth config is valid for anonymous registry
-	return "", "", nil
+	return Credentials{Username: username, Password: password}, nil
+}
+
+// docker hub is known by several hosts, all of which are pulled from registry-1.docker.io
+var dockerHubHosts = map[string]bool{
+	"docker.io":            true,
+	"index.docker.io":      true,
+	"registry-1.docker.io": true,
+}
+
+// NormalizeHost returns the host of a server address, with or without a scheme and path, mapping the docker hub
+// aliases to registry-1.docker.io.
+func NormalizeHost(address string) (string, error) {
+	if !strings.Contains(address, "://") {
+		address = "https://" + address
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", err
+	}
+	host := strings.ToLower(u.Host)
+	if dockerHubHosts[host] {
+		return "registry-1.docker.io", nil
+	}
+	return host, nil
 }
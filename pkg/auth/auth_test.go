@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"encoding/base64"
+	"testing"
+
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+)
+
+func TestNormalizeHost(t *testing.T) {
+	for _, tc := range []struct {
+		address string
+		host    string
+	}{
+		{"docker.io", "registry-1.docker.io"},
+		{"index.docker.io", "registry-1.docker.io"},
+		{"https://index.docker.io/v1/", "registry-1.docker.io"},
+		{"registry-1.docker.io", "registry-1.docker.io"},
+		{"Registry.Example.com", "registry.example.com"},
+		{"http://registry.example.com:5000/v2/", "registry.example.com:5000"},
+		{"registry.example.com:5000", "registry.example.com:5000"},
+	} {
+		host, err := NormalizeHost(tc.address)
+		if err != nil {
+			t.Errorf("NormalizeHost(%q): %v", tc.address, err)
+			continue
+		}
+		if host != tc.host {
+			t.Errorf("NormalizeHost(%q) = %q, want %q", tc.address, host, tc.host)
+		}
+	}
+}
+
+func TestParseCredentials(t *testing.T) {
+	encode := func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	}
+	for _, tc := range []struct {
+		name        string
+		auth        *criv1.AuthConfig
+		host        string
+		credentials Credentials
+	}{
+		{
+			name: "no auth config",
+			host: "registry-1.docker.io",
+		},
+		{
+			name:        "username and password without server address",
+			auth:        &criv1.AuthConfig{Username: "user", Password: "secret"},
+			host:        "registry.example.com",
+			credentials: Credentials{Username: "user", Password: "secret"},
+		},
+		{
+			name:        "docker hub alias with scheme and path",
+			auth:        &criv1.AuthConfig{ServerAddress: "https://index.docker.io/v1/", Username: "user", Password: "secret"},
+			host:        "registry-1.docker.io",
+			credentials: Credentials{Username: "user", Password: "secret"},
+		},
+		{
+			name:        "docker hub alias without scheme",
+			auth:        &criv1.AuthConfig{ServerAddress: "docker.io", Username: "user", Password: "secret"},
+			host:        "index.docker.io",
+			credentials: Credentials{Username: "user", Password: "secret"},
+		},
+		{
+			name:        "server address with scheme",
+			auth:        &criv1.AuthConfig{ServerAddress: "http://registry.example.com:5000", Username: "user", Password: "secret"},
+			host:        "registry.example.com:5000",
+			credentials: Credentials{Username: "user", Password: "secret"},
+		},
+		{
+			name: "server address of another host",
+			auth: &criv1.AuthConfig{ServerAddress: "registry.example.com", Username: "user", Password: "secret"},
+			host: "registry-1.docker.io",
+		},
+		{
+			name: "server address of another port",
+			auth: &criv1.AuthConfig{ServerAddress: "registry.example.com:5000", Username: "user", Password: "secret"},
+			host: "registry.example.com",
+		},
+		{
+			name:        "encoded auth",
+			auth:        &criv1.AuthConfig{Auth: encode("user:sec:ret")},
+			host:        "registry.example.com",
+			credentials: Credentials{Username: "user", Password: "sec:ret"},
+		},
+		{
+			name:        "token username",
+			auth:        &criv1.AuthConfig{Username: "<token>", Password: "refresh"},
+			host:        "registry.example.com",
+			credentials: Credentials{IdentityToken: "refresh"},
+		},
+		{
+			name:        "encoded token username",
+			auth:        &criv1.AuthConfig{Auth: encode("<token>:refresh")},
+			host:        "registry.example.com",
+			credentials: Credentials{IdentityToken: "refresh"},
+		},
+		{
+			name:        "identity token",
+			auth:        &criv1.AuthConfig{Username: "user", IdentityToken: "refresh"},
+			host:        "registry.example.com",
+			credentials: Credentials{IdentityToken: "refresh"},
+		},
+		{
+			name:        "registry token",
+			auth:        &criv1.AuthConfig{IdentityToken: "refresh", RegistryToken: "bearer"},
+			host:        "registry.example.com",
+			credentials: Credentials{RegistryToken: "bearer"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			credentials, err := ParseCredentials(tc.auth, tc.host)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if credentials != tc.credentials {
+				t.Errorf("got %+v, want %+v", credentials, tc.credentials)
+			}
+		})
+	}
+}
+
+func TestParseCredentialsInvalidAuth(t *testing.T) {
+	for _, auth := range []string{"not base64!", base64.StdEncoding.EncodeToString([]byte("no-colon"))} {
+		if _, err := ParseCredentials(&criv1.AuthConfig{Auth: auth}, "registry.example.com"); err == nil {
+			t.Errorf("ParseCredentials with auth %q: expected an error", auth)
+		}
+	}
+}
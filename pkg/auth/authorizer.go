@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/containerd/containerd/remotes/docker"
+	remoteauth "github.com/containerd/containerd/remotes/docker/auth"
+	"github.com/pkg/errors"
+)
+
+// the client id sent with OAuth2 token requests
+const oauthClientID = "rancher-kim"
+
+// CredentialsFunc returns the credentials for a registry host.
+type CredentialsFunc func(host string) (Credentials, error)
+
+// NewAuthorizer returns an authorizer that authenticates with registry tokens as is, exchanges identity tokens via
+// the OAuth2 refresh token grant, and otherwise defers to the containerd docker authorizer.
+func NewAuthorizer(client *http.Client, header http.Header, credentials CredentialsFunc) docker.Authorizer {
+	return &authorizer{
+		Authorizer: docker.NewDockerAuthorizer(
+			docker.WithAuthClient(client),
+			docker.WithAuthHeader(header),
+			docker.WithAuthCreds(func(host string) (string, string, error) {
+				c, err := credentials(host)
+				return c.Username, c.Password, err
+			}),
+		),
+		client:      client,
+		header:      header,
+		credentials: credentials,
+		challenges:  map[string]remoteauth.Challenge{},
+		tokens:      map[string]string{},
+	}
+}
+
+type authorizer struct {
+	docker.Authorizer
+	client      *http.Client
+	header      http.Header
+	credentials CredentialsFunc
+	// bearer challenges by host, and tokens by host and scope, for identity tokens
+	challenges map[string]remoteauth.Challenge
+	tokens     map[string]string
+	mu         sync.Mutex
+}
+
+func (a *authorizer) Authorize(ctx context.Context, req *http.Request) error {
+	host := req.URL.Host
+	credentials, err := a.credentials(host)
+	if err != nil {
+		return err
+	}
+	switch {
+	case credentials.RegistryToken != "":
+		req.Header.Set("Authorization", "Bearer "+credentials.RegistryToken)
+		return nil
+	case credentials.IdentityToken != "":
+		a.mu.Lock()
+		challenge, challenged := a.challenges[host]
+		a.mu.Unlock()
+		if !challenged {
+			return nil // anonymous until the registry asks otherwise
+		}
+		token, err := a.token(ctx, host, challenge, credentials.IdentityToken)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	default:
+		return a.Authorizer.Authorize(ctx, req)
+	}
+}
+
+func (a *authorizer) AddResponses(ctx context.Context, responses []*http.Response) error {
+	last := responses[len(responses)-1]
+	host := last.Request.URL.Host
+	credentials, err := a.credentials(host)
+	if err != nil {
+		return err
+	}
+	switch {
+	case credentials.RegistryToken != "":
+		return errors.Errorf("registry token rejected by %s", host)
+	case credentials.IdentityToken != "":
+		for _, challenge := range remoteauth.ParseAuthHeader(last.Header) {
+			if challenge.Scheme != remoteauth.BearerAuth {
+				continue
+			}
+			a.mu.Lock()
+			defer a.mu.Unlock()
+			if _, challenged := a.challenges[host]; challenged && last.Request.Header.Get("Authorization") != "" {
+				return errors.Errorf("identity token rejected by %s", host)
+			}
+			a.challenges[host] = challenge
+			return nil
+		}
+		return errors.Errorf("identity token not supported by %s, which does not use bearer auth", host)
+	default:
+		return a.Authorizer.AddResponses(ctx, responses)
+	}
+}
+
+// token exchanges the identity token for a registry token for the scopes of the request, caching it.
+func (a *authorizer) token(ctx context.Context, host string, challenge remoteauth.Challenge, identityToken string) (string, error) {
+	to, err := remoteauth.GenerateTokenOptions(ctx, host, "", identityToken, challenge)
+	if err != nil {
+		return "", err
+	}
+	to.Scopes = docker.GetTokenScopes(ctx, to.Scopes)
+	key := host + " " + strings.Join(to.Scopes, " ")
+
+	a.mu.Lock()
+	token, ok := a.tokens[key]
+	a.mu.Unlock()
+	if ok {
+		return token, nil
+	}
+	resp, err := remoteauth.FetchTokenWithOAuth(ctx, a.client, a.header, oauthClientID, to)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to exchange identity token with %s", to.Realm)
+	}
+	a.mu.Lock()
+	a.tokens[key] = resp.AccessToken
+	a.mu.Unlock()
+	return resp.AccessToken, nil
+}
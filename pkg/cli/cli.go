@@ -9,10 +9,13 @@ import (
 	"github.com/rancher/kim/pkg/cli/command/image"
 	"github.com/rancher/kim/pkg/cli/command/image/build"
 	"github.com/rancher/kim/pkg/cli/command/image/list"
+	"github.com/rancher/kim/pkg/cli/command/image/load"
 	"github.com/rancher/kim/pkg/cli/command/image/pull"
 	"github.com/rancher/kim/pkg/cli/command/image/push"
 	"github.com/rancher/kim/pkg/cli/command/image/remove"
+	"github.com/rancher/kim/pkg/cli/command/image/save"
 	"github.com/rancher/kim/pkg/cli/command/image/tag"
+	"github.com/rancher/kim/pkg/cli/command/jobs"
 	"github.com/rancher/kim/pkg/client"
 	"github.com/rancher/kim/pkg/credential/provider"
 	"github.com/rancher/kim/pkg/version"
@@ -71,13 +74,16 @@ func Main() *cobra.Command {
 		agent.Command(),
 		image.Command(),
 		builder.Command(),
+		jobs.Command(),
 	)
 	// image subsystem shortcuts
 	AddShortcut(app, build.Use, "image", "build")
 	AddShortcut(app, list.Use("images"), "image", "list")
+	AddShortcut(app, load.Use, "image", "load")
 	AddShortcut(app, pull.Use, "image", "pull")
 	AddShortcut(app, push.Use, "image", "push")
 	AddShortcut(app, remove.Use("rmi"), "image", "remove")
+	AddShortcut(app, save.Use, "image", "save")
 	AddShortcut(app, tag.Use, "image", "tag")
 	return app
 }
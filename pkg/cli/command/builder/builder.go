@@ -3,8 +3,13 @@ package builder
 import (
 	"fmt"
 
+	"github.com/rancher/kim/pkg/cli/command/builder/certs"
+	"github.com/rancher/kim/pkg/cli/command/builder/du"
 	"github.com/rancher/kim/pkg/cli/command/builder/install"
 	"github.com/rancher/kim/pkg/cli/command/builder/login"
+	"github.com/rancher/kim/pkg/cli/command/builder/logout"
+	"github.com/rancher/kim/pkg/cli/command/builder/prune"
+	"github.com/rancher/kim/pkg/cli/command/builder/status"
 	"github.com/rancher/kim/pkg/cli/command/builder/uninstall"
 	wrangler "github.com/rancher/wrangler-cli"
 	"github.com/spf13/cobra"
@@ -28,6 +33,11 @@ func Command() *cobra.Command {
 		install.Command(),
 		uninstall.Command(),
 		login.Command(),
+		logout.Command(),
+		du.Command(),
+		certs.Command(),
+		prune.Command(),
+		status.Command(),
 	)
 	return cmd
 }
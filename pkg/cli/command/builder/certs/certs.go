@@ -0,0 +1,40 @@
+package certs
+
+import (
+	"fmt"
+
+	"github.com/rancher/kim/pkg/cli/command/builder/certs/issue"
+	"github.com/rancher/kim/pkg/cli/command/builder/certs/rotate"
+	"github.com/rancher/kim/pkg/cli/command/builder/certs/status"
+	wrangler "github.com/rancher/wrangler-cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	Short = "Manage the builder TLS certificates"
+)
+
+func Use(sub string) string {
+	return fmt.Sprintf("%s [OPTIONS] COMMAND", sub)
+}
+
+func Command() *cobra.Command {
+	cmd := wrangler.Command(&CommandSpec{}, cobra.Command{
+		Use:                   Use("certs"),
+		Short:                 Short,
+		DisableFlagsInUseLine: true,
+	})
+	cmd.AddCommand(
+		issue.Command(),
+		rotate.Command(),
+		status.Command(),
+	)
+	return cmd
+}
+
+type CommandSpec struct {
+}
+
+func (s *CommandSpec) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,34 @@
+package issue
+
+import (
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/client/builder"
+	wrangler "github.com/rancher/wrangler-cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	Use   = "issue [OPTIONS] USER"
+	Short = "Issue a client certificate for a user, stored in their namespace"
+)
+
+func Command() *cobra.Command {
+	return wrangler.Command(&CommandSpec{}, cobra.Command{
+		Use:                   Use,
+		Short:                 Short,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+	})
+}
+
+type CommandSpec struct {
+	builder.CertsIssue
+}
+
+func (s *CommandSpec) Run(cmd *cobra.Command, args []string) error {
+	k8s, err := client.DefaultConfig.Interface()
+	if err != nil {
+		return err
+	}
+	return s.CertsIssue.Do(cmd.Context(), k8s, args[0])
+}
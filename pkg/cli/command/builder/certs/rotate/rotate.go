@@ -0,0 +1,34 @@
+package rotate
+
+import (
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/client/builder"
+	wrangler "github.com/rancher/wrangler-cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	Use   = "rotate [OPTIONS]"
+	Short = "Regenerate the builder certificates and restart the builder"
+)
+
+func Command() *cobra.Command {
+	return wrangler.Command(&CommandSpec{}, cobra.Command{
+		Use:                   Use,
+		Short:                 Short,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+	})
+}
+
+type CommandSpec struct {
+	builder.CertsRotate
+}
+
+func (s *CommandSpec) Run(cmd *cobra.Command, _ []string) error {
+	k8s, err := client.DefaultConfig.Interface()
+	if err != nil {
+		return err
+	}
+	return s.CertsRotate.Do(cmd.Context(), k8s)
+}
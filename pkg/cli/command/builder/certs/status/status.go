@@ -0,0 +1,34 @@
+package status
+
+import (
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/client/builder"
+	wrangler "github.com/rancher/wrangler-cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	Use   = "status [OPTIONS]"
+	Short = "Show the expiry of the builder certificates"
+)
+
+func Command() *cobra.Command {
+	return wrangler.Command(&CommandSpec{}, cobra.Command{
+		Use:                   Use,
+		Short:                 Short,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+	})
+}
+
+type CommandSpec struct {
+	builder.CertsStatus
+}
+
+func (s *CommandSpec) Run(cmd *cobra.Command, _ []string) error {
+	k8s, err := client.DefaultConfig.Interface()
+	if err != nil {
+		return err
+	}
+	return s.CertsStatus.Do(cmd.Context(), k8s)
+}
@@ -0,0 +1,29 @@
+package du
+
+import (
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/client/builder"
+	wrangler "github.com/rancher/wrangler-cli"
+	"github.com/spf13/cobra"
+)
+
+func Command() *cobra.Command {
+	return wrangler.Command(&CommandSpec{}, cobra.Command{
+		Use:                   "du [OPTIONS]",
+		Short:                 "Show disk usage of the builder cache",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+	})
+}
+
+type CommandSpec struct {
+	builder.DiskUsage
+}
+
+func (s *CommandSpec) Run(cmd *cobra.Command, _ []string) error {
+	k8s, err := client.DefaultConfig.Interface()
+	if err != nil {
+		return err
+	}
+	return s.DiskUsage.Do(cmd.Context(), k8s)
+}
@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"fmt"
 	"io/ioutil"
-	"net/url"
 	"os"
 	"strings"
 
@@ -14,7 +13,6 @@ import (
 	"github.com/rancher/kim/pkg/client/builder"
 	wrangler "github.com/rancher/wrangler-cli"
 	"github.com/spf13/cobra"
-	"k8s.io/kubernetes/pkg/credentialprovider"
 )
 
 func Command() *cobra.Command {
@@ -22,7 +20,7 @@ func Command() *cobra.Command {
 		Use:                   "login [OPTIONS] [SERVER]",
 		Short:                 "Establish credentials for a registry.",
 		DisableFlagsInUseLine: true,
-		Args:                  cobra.ExactArgs(1),
+		Args:                  cobra.MaximumNArgs(1),
 	})
 }
 
@@ -35,6 +33,19 @@ func (s *CommandSpec) Run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if s.List {
+		if len(args) > 0 {
+			return errors.New("--list does not accept a server")
+		}
+		return s.Login.ListCredentials(cmd.Context(), k8s)
+	}
+	if len(args) != 1 {
+		return errors.New("requires a server, or --list")
+	}
+	server, err := builder.NormalizeServer(args[0])
+	if err != nil {
+		return err
+	}
 	if s.PasswordStdin {
 		if s.Password != "" {
 			return errors.New("--password and --password-stdin are mutually exclusive")
@@ -80,20 +91,5 @@ func (s *CommandSpec) Run(cmd *cobra.Command, args []string) error {
 			return errors.New("password is required")
 		}
 	}
-	server, err := credentialprovider.ParseSchemelessURL(args[0])
-	if err != nil {
-		if server, err = url.Parse(args[0]); err != nil {
-			return err
-		}
-	}
-	// special case for [*.]docker.io -> https://index.docker.io/v1/
-	if strings.HasSuffix(server.Host, "docker.io") {
-		server.Scheme = "https"
-		server.Host = "index.docker.io"
-		if server.Path == "" {
-			server.Path = "/v1/"
-		}
-		return s.Login.Do(cmd.Context(), k8s, server.String())
-	}
-	return s.Login.Do(cmd.Context(), k8s, server.Host)
+	return s.Login.Do(cmd.Context(), k8s, server)
 }
@@ -0,0 +1,33 @@
+package logout
+
+import (
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/client/builder"
+	wrangler "github.com/rancher/wrangler-cli"
+	"github.com/spf13/cobra"
+)
+
+func Command() *cobra.Command {
+	return wrangler.Command(&CommandSpec{}, cobra.Command{
+		Use:                   "logout [OPTIONS] SERVER",
+		Short:                 "Remove the credentials for a registry.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+	})
+}
+
+type CommandSpec struct {
+	builder.Logout
+}
+
+func (s *CommandSpec) Run(cmd *cobra.Command, args []string) error {
+	k8s, err := client.DefaultConfig.Interface()
+	if err != nil {
+		return err
+	}
+	server, err := builder.NormalizeServer(args[0])
+	if err != nil {
+		return err
+	}
+	return s.Logout.Do(cmd.Context(), k8s, server)
+}
@@ -0,0 +1,29 @@
+package status
+
+import (
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/client/builder"
+	wrangler "github.com/rancher/wrangler-cli"
+	"github.com/spf13/cobra"
+)
+
+func Command() *cobra.Command {
+	return wrangler.Command(&CommandSpec{}, cobra.Command{
+		Use:                   "status [OPTIONS]",
+		Short:                 "Show the health of the builder",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+	})
+}
+
+type CommandSpec struct {
+	builder.Status
+}
+
+func (s *CommandSpec) Run(cmd *cobra.Command, _ []string) error {
+	k8s, err := client.DefaultConfig.Interface()
+	if err != nil {
+		return err
+	}
+	return s.Status.Do(cmd.Context(), k8s)
+}
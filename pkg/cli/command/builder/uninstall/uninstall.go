@@ -29,5 +29,9 @@ func (s *CommandSpec) Run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	err = s.Uninstall.RBAC(ctx, k8s)
+	if err != nil {
+		return err
+	}
 	return s.Uninstall.NodeRole(ctx, k8s)
 }
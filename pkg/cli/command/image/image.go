@@ -4,10 +4,15 @@ import (
 	"fmt"
 
 	"github.com/rancher/kim/pkg/cli/command/image/build"
+	"github.com/rancher/kim/pkg/cli/command/image/history"
+	"github.com/rancher/kim/pkg/cli/command/image/inspect"
 	"github.com/rancher/kim/pkg/cli/command/image/list"
+	"github.com/rancher/kim/pkg/cli/command/image/load"
+	"github.com/rancher/kim/pkg/cli/command/image/prune"
 	"github.com/rancher/kim/pkg/cli/command/image/pull"
 	"github.com/rancher/kim/pkg/cli/command/image/push"
 	"github.com/rancher/kim/pkg/cli/command/image/remove"
+	"github.com/rancher/kim/pkg/cli/command/image/save"
 	"github.com/rancher/kim/pkg/cli/command/image/tag"
 	wrangler "github.com/rancher/wrangler-cli"
 	"github.com/spf13/cobra"
@@ -30,10 +35,15 @@ func Command() *cobra.Command {
 	})
 	cmd.AddCommand(
 		build.Command(),
+		history.Command(),
+		inspect.Command(),
 		list.Command(),
+		load.Command(),
+		prune.Command(),
 		pull.Command(),
 		push.Command(),
 		remove.Command(),
+		save.Command(),
 		tag.Command(),
 	)
 	return cmd
@@ -11,7 +11,7 @@ import (
 )
 
 const (
-	Short = "Remove an image"
+	Short = "Remove one or more images"
 )
 
 func Use(sub string) string {
@@ -41,5 +41,5 @@ func (c *CommandSpec) Run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	return c.Remove.Do(cmd.Context(), k8s, args[0])
+	return c.Remove.Do(cmd.Context(), k8s, args)
 }
@@ -0,0 +1,39 @@
+package save
+
+import (
+	"github.com/rancher/kim/pkg/cli/command/builder/install"
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/client/image"
+	wrangler "github.com/rancher/wrangler-cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	Use   = "save [OPTIONS] IMAGE [IMAGE...]"
+	Short = "Save one or more images to a tar archive (streamed to STDOUT by default)"
+)
+
+func Command() *cobra.Command {
+	return wrangler.Command(&CommandSpec{}, cobra.Command{
+		Use:                   Use,
+		Short:                 Short,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MinimumNArgs(1),
+	})
+}
+
+type CommandSpec struct {
+	image.Save
+}
+
+func (s *CommandSpec) Run(cmd *cobra.Command, args []string) error {
+	k8s, err := client.DefaultConfig.Interface()
+	if err != nil {
+		return err
+	}
+	err = install.Check(cmd.Context())
+	if err != nil {
+		return err
+	}
+	return s.Save.Do(cmd.Context(), k8s, args)
+}
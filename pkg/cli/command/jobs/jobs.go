@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"fmt"
+
+	"github.com/rancher/kim/pkg/cli/command/jobs/cancel"
+	"github.com/rancher/kim/pkg/cli/command/jobs/list"
+	"github.com/rancher/kim/pkg/cli/command/jobs/watch"
+	wrangler "github.com/rancher/wrangler-cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	Short = "Manage Jobs (builds, pulls and pushes)"
+)
+
+func Use(sub string) string {
+	return fmt.Sprintf("%s [OPTIONS] COMMAND", sub)
+}
+
+func Command() *cobra.Command {
+	cmd := wrangler.Command(&CommandSpec{}, cobra.Command{
+		Use:                   Use("jobs"),
+		Short:                 Short,
+		DisableFlagsInUseLine: true,
+	})
+	cmd.AddCommand(
+		cancel.Command(),
+		list.Command(),
+		watch.Command(),
+	)
+	return cmd
+}
+
+type CommandSpec struct {
+}
+
+func (s *CommandSpec) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
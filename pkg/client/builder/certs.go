@@ -0,0 +1,509 @@
+package builder
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/pkg/errors"
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/server"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/retry"
+)
+
+// certRenewBefore is how long before they expire that certificates are considered due for rotation
+const certRenewBefore = 30 * 24 * time.Hour
+
+// the secrets holding the certificates of the builder, in the order they are reported
+var certSecrets = []string{"kim-tls-ca", "kim-tls-server", "kim-tls-client"}
+
+// CertsStatus reports the expiry of the builder certificates.
+type CertsStatus struct {
+}
+
+func (s *CertsStatus) Do(_ context.Context, k *client.Interface) error {
+	w := tabwriter.NewWriter(os.Stdout, 1, 8, 3, ' ', 0)
+	fmt.Fprintln(w, "SECRET\tSUBJECT\tNOT AFTER\tEXPIRES\tSTATUS")
+	for _, name := range certSecrets {
+		certs, err := client.LoadCerts(k.Core.Secret(), k.Namespace, name)
+		if apierr.IsNotFound(err) && name == "kim-tls-ca" {
+			// externally issued certificates come with the ca.crt of their issuer instead
+			if clientCert, caErr := k.Core.Secret().Get(k.Namespace, "kim-tls-client", metav1.GetOptions{}); caErr == nil && len(clientCert.Data[client.CACertKey]) > 0 {
+				name = "kim-tls-client (" + client.CACertKey + ")"
+				certs, err = certutil.ParseCertsPEM(clientCert.Data[client.CACertKey])
+			}
+		}
+		if apierr.IsNotFound(err) {
+			fmt.Fprintf(w, "%s\t\t\t\t%s\n", name, "missing")
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to load %s", name)
+		}
+		// a rolled CA is followed by its predecessor
+		for i, crt := range certs {
+			secret := name
+			if i > 0 {
+				secret = name + " (previous)"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", secret, crt.Subject.CommonName, crt.NotAfter.Local().Format(time.RFC3339), expires(crt), certStatus(crt))
+		}
+	}
+	return w.Flush()
+}
+
+// CertsRotate regenerates the builder certificates and restarts the builder so that they take effect.
+type CertsRotate struct {
+	CA        bool `usage:"Also roll the certificate authority, trusting the previous alongside the new until the next rotation"`
+	NoRestart bool `usage:"Do not restart the builder to pick up the new certificates"`
+}
+
+func (s *CertsRotate) Do(ctx context.Context, k *client.Interface) error {
+	secrets := k.Core.Secret()
+	// externally issued certificates are renewed by their issuer, pre-issued ones are copied again from their sources
+	if server, err := secrets.Get(k.Namespace, "kim-tls-server", metav1.GetOptions{}); err == nil {
+		if _, ok := server.Annotations[certManagerCertificateAnnotation]; ok {
+			if err = renewCertManagerCertificates(ctx, k); err != nil {
+				return err
+			}
+			if s.NoRestart {
+				logrus.Info("Restart the builder for the new certificates to take effect")
+				return nil
+			}
+			return restartBuilder(ctx, k)
+		}
+		if serverSecret, ok := server.Annotations[tlsSourceAnnotation]; ok {
+			clientSecret, err := secrets.Get(k.Namespace, "kim-tls-client", metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			return preIssuedCerts(ctx, k, serverSecret, clientSecret.Annotations[tlsSourceAnnotation])
+		}
+	}
+	load := client.LoadOrGenCA
+	if s.CA {
+		if ca, err := secrets.Get(k.Namespace, "kim-tls-ca", metav1.GetOptions{}); err == nil && ca.Annotations[tlsSourceAnnotation] != "" {
+			return errors.Errorf("the certificate authority is copied from %s, replace it there and re-run `kim builder install --tls-ca-secret`", ca.Annotations[tlsSourceAnnotation])
+		}
+		logrus.Info("Rotating certificate authority")
+		load = client.RotateCA
+	}
+	caCert, caKey, err := load(secrets, k.Namespace, "kim-tls-ca")
+	if err != nil {
+		return errors.Wrap(err, "failed to assert certificate authority")
+	}
+	if !s.CA && dueForRotation(caCert) {
+		logrus.Warnf("Certificate authority expires %s, rotate it with --ca", expires(caCert))
+	}
+	caBundle, err := publishCABundle(k)
+	if err != nil {
+		return errors.Wrap(err, "failed to publish certificate authority")
+	}
+	endpointAddr := ""
+	if svc, err := k.Core.Service().Get(k.Namespace, "builder", metav1.GetOptions{}); err == nil {
+		endpointAddr = svc.Annotations["images.cattle.io/endpoint-override"]
+	}
+	domains, ips, err := serverNames(k, endpointAddr)
+	if err != nil {
+		return err
+	}
+	logrus.Info("Rotating server cert+key")
+	if _, _, err = client.RotateServerCert(secrets, k.Namespace, "kim-tls-server", caCert, caKey, "kube-image-server", nil, domains, ips); err != nil {
+		return errors.Wrap(err, "failed to rotate server cert+key")
+	}
+	logrus.Info("Rotating client cert+key")
+	if _, _, err = client.RotateClientCert(secrets, k.Namespace, "kim-tls-client", caCert, caKey, "kube-image-client", caBundle); err != nil {
+		return errors.Wrap(err, "failed to rotate client cert+key")
+	}
+	if s.NoRestart {
+		logrus.Info("Restart the builder for the new certificates to take effect")
+		return nil
+	}
+	return restartBuilder(ctx, k)
+}
+
+// CertsIssue issues a client cert+key for a user, signed by the kim CA, so that the agent can tell users apart.
+type CertsIssue struct {
+	Group         []string `usage:"Group(s) of the user, recorded as the organization(s) of the certificate (ignored by agents installed with --token-auth)"`
+	ReadOnly      bool     `usage:"Only permit the user to list, inspect and watch, i.e. not to build, pull, push, tag or remove"`
+	UserNamespace string   `usage:"Namespace of the user to store the kim-tls-client secret in (default: that of the kubeconfig context)"`
+}
+
+func (s *CertsIssue) Do(_ context.Context, k *client.Interface, user string) error {
+	if strings.Contains(user, ",o=") {
+		return errors.Errorf("invalid user %q", user)
+	}
+	namespace := s.UserNamespace
+	if namespace == "" {
+		namespace = k.UserNamespace
+	}
+	if namespace == "" || namespace == k.Namespace {
+		return errors.Errorf("the client cert+key of %s must be stored in a namespace other than %s, specify --user-namespace", user, k.Namespace)
+	}
+	caCert, caKey, err := client.LoadCA(k.Core.Secret(), k.Namespace, "kim-tls-ca")
+	if apierr.IsNotFound(err) {
+		return errors.New("the builder certificates are issued externally, issue client certificates for users there")
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to load certificate authority")
+	}
+	caBundle, err := publishCABundle(k)
+	if err != nil {
+		return errors.Wrap(err, "failed to publish certificate authority")
+	}
+	groups := s.Group
+	if s.ReadOnly {
+		groups = append(groups, server.ReadOnlyGroup)
+	}
+	cn := user
+	for _, group := range groups {
+		cn += ",o=" + group
+	}
+	logrus.Infof("Issuing client cert+key for %s into %s/kim-tls-client", user, namespace)
+	_, _, err = client.RotateClientCert(k.Core.Secret(), namespace, "kim-tls-client", caCert, caKey, cn, caBundle)
+	return err
+}
+
+// publishCABundle copies the certificate(s) of the kim CA, without its key, to the kim-ca-bundle config map, from
+// which clients verify the builder, and returns them to be stored as the ca.crt of client certificates. Clients never
+// read the kim-tls-ca secret, as anyone who can read it can issue themselves a certificate of any user or group.
+func publishCABundle(k *client.Interface) ([]byte, error) {
+	ca, err := k.Core.Secret().Get(k.Namespace, "kim-tls-ca", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	bundle := ca.Data[corev1.TLSCertKey]
+	return bundle, applyConfigMap(k, client.CABundleConfigMap, map[string]string{client.CACertKey: string(bundle)})
+}
+
+// serverNames returns the DNS names and IPs that the server certificate is valid for: the service, the endpoint
+// override and the addresses of the builder nodes.
+func serverNames(k *client.Interface, endpointAddr string) ([]string, []net.IP, error) {
+	nodeList, err := k.Core.Node().List(metav1.ListOptions{
+		LabelSelector: "node-role.kubernetes.io/builder==true",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ips := []net.IP{}
+	domains := []string{
+		fmt.Sprintf("builder.%s.svc", k.Namespace),
+	}
+	if endpointIP := net.ParseIP(endpointAddr); endpointIP != nil {
+		ips = append(ips, endpointIP)
+	} else if endpointAddr != "" {
+		domains = append(domains, endpointAddr)
+	}
+	for _, node := range nodeList.Items {
+		for _, addr := range node.Status.Addresses {
+			switch addr.Type {
+			case corev1.NodeInternalIP:
+				ips = append(ips, net.ParseIP(addr.Address))
+			case corev1.NodeHostName:
+				domains = append(domains, addr.Address)
+			}
+		}
+	}
+	return domains, ips, nil
+}
+
+// the annotation on copies of bring-your-own certificate secrets naming the secret they were copied from
+const tlsSourceAnnotation = "images.cattle.io/tls-source"
+
+// the annotation that cert-manager sets on the secrets of the certificates it issues
+const certManagerCertificateAnnotation = "cert-manager.io/certificate-name"
+
+// the annotation on the builder pod template with the hash of the certificates that the pods run with
+const tlsHashAnnotation = "images.cattle.io/tls-hash"
+
+// how long to wait for cert-manager to re-issue renewed certificates
+const certManagerRenewTimeout = 2 * time.Minute
+
+var certManagerCertificates = schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+
+// copyTLSSecret copies the cert+key, and ca.crt if required, from a secret in the builder namespace.
+func copyTLSSecret(k *client.Interface, from, to string, requireCA bool) error {
+	source, err := k.Core.Secret().Get(k.Namespace, from, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	data := map[string][]byte{}
+	keys := []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey}
+	if requireCA {
+		keys = append(keys, client.CACertKey)
+	}
+	for _, key := range keys {
+		if len(source.Data[key]) == 0 {
+			return errors.Errorf("secret %s is missing %s", from, key)
+		}
+		data[key] = source.Data[key]
+	}
+	logrus.Infof("Copying %s to %s", from, to)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := k.Core.Secret().Get(k.Namespace, to, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			_, err = k.Core.Secret().Create(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      to,
+					Namespace: k.Namespace,
+					Labels: labels.Set{
+						"app.kubernetes.io/managed-by": "kim",
+					},
+					Annotations: map[string]string{
+						tlsSourceAnnotation: from,
+					},
+				},
+				Data: data,
+				Type: corev1.SecretTypeTLS,
+			})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[tlsSourceAnnotation] = from
+		secret.Data = data
+		_, err = k.Core.Secret().Update(secret)
+		return err
+	})
+}
+
+// preIssuedCerts copies the pre-issued server and client cert+key, restarting the builder if either has changed.
+func preIssuedCerts(ctx context.Context, k *client.Interface, serverSecret, clientSecret string) error {
+	if err := copyTLSSecret(k, serverSecret, "kim-tls-server", true); err != nil {
+		return errors.Wrap(err, "failed to assert server cert+key")
+	}
+	if err := copyTLSSecret(k, clientSecret, "kim-tls-client", true); err != nil {
+		return errors.Wrap(err, "failed to assert client cert+key")
+	}
+	return restartBuilder(ctx, k)
+}
+
+// certManagerCertificates asserts the cert-manager Certificates for the server and client cert+key. The issuer must
+// populate ca.crt, as the CA and Vault issuers do.
+func (a *Install) certManagerCertificates(_ context.Context, k *client.Interface) error {
+	logrus.Infof("Asserting cert-manager certificates issued by %s %s", a.CertManagerIssuerKind, a.CertManagerIssuer)
+	domains, ips, err := serverNames(k, a.EndpointAddr)
+	if err != nil {
+		return err
+	}
+	ipAddresses := make([]interface{}, len(ips))
+	for i, ip := range ips {
+		ipAddresses[i] = ip.String()
+	}
+	dnsNames := make([]interface{}, len(domains))
+	for i, domain := range domains {
+		dnsNames[i] = domain
+	}
+	certificate := func(name, cn string, spec map[string]interface{}) *unstructured.Unstructured {
+		spec["secretName"] = name
+		spec["commonName"] = cn
+		spec["issuerRef"] = map[string]interface{}{
+			"group": "cert-manager.io",
+			"kind":  a.CertManagerIssuerKind,
+			"name":  a.CertManagerIssuer,
+		}
+		spec["privateKey"] = map[string]interface{}{
+			"algorithm": "ECDSA",
+			"size":      int64(256),
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": k.Namespace,
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/managed-by": "kim",
+				},
+			},
+			"spec": spec,
+		}}
+	}
+	return k.Apply.WithSetID("kim-certificates").ApplyObjects(
+		certificate("kim-tls-server", "kube-image-server", map[string]interface{}{
+			"dnsNames":    dnsNames,
+			"ipAddresses": ipAddresses,
+			"usages":      []interface{}{"server auth", "digital signature", "key encipherment"},
+		}),
+		certificate("kim-tls-client", "kube-image-client", map[string]interface{}{
+			"usages": []interface{}{"client auth", "digital signature", "key encipherment"},
+		}),
+	)
+}
+
+// externalCerts returns true when the server and client cert+key are issued outside of kim, with the ca.crt of their
+// issuer alongside.
+func (a *Install) externalCerts() bool {
+	return a.TlsServerSecret != "" || a.CertManagerIssuer != ""
+}
+
+// renewCertManagerCertificates has cert-manager re-issue the server and client cert+key, as `cmctl renew` does, and
+// waits for the secrets to be updated.
+func renewCertManagerCertificates(ctx context.Context, k *client.Interface) error {
+	certificates := k.Dynamic.Resource(certManagerCertificates).Namespace(k.Namespace)
+	issued := map[string]string{}
+	for _, name := range []string{"kim-tls-server", "kim-tls-client"} {
+		secret, err := k.Core.Secret().Get(k.Namespace, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		issued[name] = string(secret.Data[corev1.TLSCertKey])
+		certificateName := secret.Annotations[certManagerCertificateAnnotation]
+		if certificateName == "" {
+			certificateName = name
+		}
+		logrus.Infof("Renewing cert-manager certificate %s", certificateName)
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			certificate, err := certificates.Get(ctx, certificateName, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			conditions, _, _ := unstructured.NestedSlice(certificate.Object, "status", "conditions")
+			var updated []interface{}
+			for _, condition := range conditions {
+				if c, ok := condition.(map[string]interface{}); ok && c["type"] == "Issuing" {
+					continue
+				}
+				updated = append(updated, condition)
+			}
+			updated = append(updated, map[string]interface{}{
+				"type":               "Issuing",
+				"status":             "True",
+				"reason":             "ManuallyTriggered",
+				"message":            "Certificate re-issuance manually triggered by kim",
+				"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+			})
+			if err = unstructured.SetNestedSlice(certificate.Object, updated, "status", "conditions"); err != nil {
+				return err
+			}
+			_, err = certificates.UpdateStatus(ctx, certificate, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to renew cert-manager certificate %s", certificateName)
+		}
+	}
+	logrus.Info("Waiting for cert-manager to re-issue the certificates")
+	err := wait.PollImmediate(2*time.Second, certManagerRenewTimeout, func() (bool, error) {
+		for name, crt := range issued {
+			secret, err := k.Core.Secret().Get(k.Namespace, name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			if string(secret.Data[corev1.TLSCertKey]) == crt {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return errors.Errorf("cert-manager has not re-issued the certificates within %s, see `kubectl describe -n %s certificates`", certManagerRenewTimeout, k.Namespace)
+	}
+	return err
+}
+
+// tlsHash returns a hash of the builder certificates, which the builder pods are annotated with so that they are
+// rolled whenever the certificates change.
+func tlsHash(k *client.Interface) (string, error) {
+	hash := sha256.New()
+	for _, name := range certSecrets {
+		secret, err := k.Core.Secret().Get(k.Namespace, name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, client.CACertKey} {
+			fmt.Fprintf(hash, "%s/%s=", name, key)
+			hash.Write(secret.Data[key])
+			hash.Write([]byte{0})
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// restartBuilder rolls the builder pods, if installed and their certificates have changed, by annotating their
+// template with the hash of the certificates.
+func restartBuilder(_ context.Context, k *client.Interface) error {
+	hash, err := tlsHash(k)
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		daemon, err := k.Apps.DaemonSet().Get(k.Namespace, "builder", metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if daemon.Spec.Template.Annotations[tlsHashAnnotation] == hash {
+			return nil
+		}
+		logrus.Info("Restarting builder")
+		if daemon.Spec.Template.Annotations == nil {
+			daemon.Spec.Template.Annotations = map[string]string{}
+		}
+		daemon.Spec.Template.Annotations[tlsHashAnnotation] = hash
+		_, err = k.Apps.DaemonSet().Update(daemon)
+		return err
+	})
+}
+
+// rotationReason returns why the certificate should be rotated, if it should.
+func rotationReason(crt, ca *x509.Certificate) string {
+	if crt.CheckSignatureFrom(ca) != nil {
+		return "was not issued by the current certificate authority"
+	}
+	if dueForRotation(crt) {
+		return "expires " + expires(crt)
+	}
+	return ""
+}
+
+func dueForRotation(crt *x509.Certificate) bool {
+	return time.Now().Add(certRenewBefore).After(crt.NotAfter)
+}
+
+func expires(crt *x509.Certificate) string {
+	if d := time.Until(crt.NotAfter); d > 0 {
+		return "in " + units.HumanDuration(d)
+	}
+	return units.HumanDuration(time.Since(crt.NotAfter)) + " ago"
+}
+
+func certStatus(crt *x509.Certificate) string {
+	switch {
+	case time.Now().After(crt.NotAfter):
+		return "expired"
+	case time.Now().Before(crt.NotBefore):
+		return "not yet valid"
+	case dueForRotation(crt):
+		return "expiring"
+	default:
+		return "valid"
+	}
+}
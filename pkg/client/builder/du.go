@@ -0,0 +1,95 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/go-units"
+	controlapi "github.com/moby/buildkit/api/services/control"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+)
+
+// DiskUsage of the builder cache.
+type DiskUsage struct {
+	Filter  []string `usage:"Filter cache records, e.g. type=regular" short:"f"`
+	Verbose bool     `usage:"Show the type and description of cache records" short:"v"`
+}
+
+func (s *DiskUsage) Do(ctx context.Context, k *client.Interface) error {
+	type nodeUsage struct {
+		node  string
+		usage []*controlapi.UsageRecord
+	}
+	var results []nodeUsage
+	err := client.ImagesEach(ctx, k, func(ctx context.Context, node string, imagesClient imagesv1.ImagesClient) error {
+		res, err := imagesClient.CacheUsage(ctx, &controlapi.DiskUsageRequest{Filter: s.Filter})
+		if err != nil {
+			return err
+		}
+		usage := res.Record
+		// largest first, like buildctl
+		sort.Slice(usage, func(i, j int) bool {
+			return usage[i].Size_ > usage[j].Size_
+		})
+		results = append(results, nodeUsage{node: node, usage: usage})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// only show the node column when querying more than one builder
+	showNode := len(results) > 1
+
+	w := tabwriter.NewWriter(os.Stdout, 1, 8, 3, ' ', 0)
+	var header []string
+	if showNode {
+		header = append(header, "NODE")
+	}
+	header = append(header, "ID", "RECLAIMABLE", "SIZE", "LAST ACCESSED")
+	if s.Verbose {
+		header = append(header, "TYPE", "DESCRIPTION")
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	var total, reclaimable int64
+	for _, result := range results {
+		for _, du := range result.usage {
+			var row []string
+			if showNode {
+				row = append(row, result.node)
+			}
+			id := du.ID
+			if du.Mutable {
+				id += "*"
+			}
+			lastAccessed := ""
+			if du.LastUsedAt != nil {
+				lastAccessed = units.HumanDuration(time.Since(*du.LastUsedAt)) + " ago"
+			}
+			row = append(row, id, fmt.Sprintf("%t", !du.InUse), units.HumanSize(float64(du.Size_)), lastAccessed)
+			if s.Verbose {
+				row = append(row, string(du.RecordType), du.Description)
+			}
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+			if du.Shared {
+				continue
+			}
+			total += du.Size_
+			if !du.InUse {
+				reclaimable += du.Size_
+			}
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("Reclaimable: %s\n", units.HumanSize(float64(reclaimable)))
+	fmt.Printf("Total: %s\n", units.HumanSize(float64(total)))
+	return nil
+}
@@ -3,17 +3,21 @@ package builder
 import (
 	"context"
 	"fmt"
-	"net"
+	"io/ioutil"
 	"net/url"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/registries"
 	"github.com/rancher/kim/pkg/server"
 	"github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -27,7 +31,18 @@ type Install struct {
 	Selector     string `usage:"Selector for nodes (label query) to apply builder role"`
 	NoWait       bool   `usage:"Do not wait for backend to become available"`
 	NoFail       bool   `usage:"Do not fail if backend components are already installed"`
-	EndpointAddr string `usage:"Override the endpoint address" hidden:"true"`
+	EndpointAddr string `usage:"Override the endpoint address (for --transport=direct)" hidden:"true"`
+	// GC policy for buildkitd, replacing its defaults when either is specified
+	GcKeepDuration string `usage:"Garbage-collect builder cache not used within this duration, e.g. 168h"`
+	GcKeepStorage  string `usage:"Garbage-collect builder cache beyond this size, e.g. 20GB"`
+	Registries     string `usage:"Registries config (k3s registries.yaml format) with mirrors and TLS for the builder (default: the k3s registries.yaml on each node)"`
+	// bring-your-own certificates, from secrets in the builder namespace, rather than self-signed
+	TlsCaSecret           string `usage:"Sign the builder certificates with the CA cert+key in this secret rather than generating a CA"`
+	TlsServerSecret       string `usage:"Use the pre-issued server cert+key, with the ca.crt of its issuer, in this secret (requires --tls-client-secret)"`
+	TlsClientSecret       string `usage:"Use the pre-issued client cert+key, with the ca.crt of its issuer, in this secret (requires --tls-server-secret)"`
+	CertManagerIssuer     string `usage:"Have the builder certificates issued by this cert-manager issuer rather than self-signed"`
+	CertManagerIssuerKind string `usage:"Kind of the cert-manager issuer, Issuer or ClusterIssuer" default:"Issuer"`
+	TokenAuth             bool   `usage:"Authenticate clients by their kubeconfig bearer tokens and authorize them with RBAC on images and jobs in the kim.cattle.io group"`
 	server.Config
 }
 
@@ -62,6 +77,18 @@ func (a *Install) Do(ctx context.Context, k8s *client.Interface) error {
 	if err := a.Service(ctx, k8s); err != nil {
 		return a.checkNoFail(err)
 	}
+	// assert service account and token review delegation
+	if err := a.RBAC(ctx, k8s); err != nil {
+		return a.checkNoFail(err)
+	}
+	// assert registries config
+	if err := a.RegistriesConfig(ctx, k8s); err != nil {
+		return a.checkNoFail(err)
+	}
+	// assert buildkitd config
+	if err := a.BuildkitConfig(ctx, k8s); err != nil {
+		return a.checkNoFail(err)
+	}
 	// assert daemonset
 	if err := a.DaemonSet(ctx, k8s); err != nil {
 		return a.checkNoFail(err)
@@ -87,8 +114,8 @@ func (a *Install) Do(ctx context.Context, k8s *client.Interface) error {
 			if err != nil {
 				return err
 			}
-			if daemon.Status.NumberReady == 0 {
-				logrus.Infof("Waiting on builder daemon availability...")
+			if daemon.Status.NumberReady == 0 || daemon.Status.NumberReady < daemon.Status.DesiredNumberScheduled {
+				logrus.Infof("Waiting on builder daemon availability (%d/%d ready)...", daemon.Status.NumberReady, daemon.Status.DesiredNumberScheduled)
 				return retryMe
 			}
 			return nil
@@ -123,7 +150,7 @@ func (_ *Install) Namespace(_ context.Context, k *client.Interface) error {
 	})
 }
 
-func (a *Install) Secrets(_ context.Context, k *client.Interface) error {
+func (a *Install) Secrets(ctx context.Context, k *client.Interface) error {
 	logrus.Info("Asserting TLS secrets")
 	secrets := k.Core.Secret()
 	if a.Force {
@@ -136,47 +163,74 @@ func (a *Install) Secrets(_ context.Context, k *client.Interface) error {
 		secrets.Delete(k.Namespace, "kim-tls-ca", &deleteOptions)
 	}
 
+	switch {
+	case (a.TlsServerSecret == "") != (a.TlsClientSecret == ""):
+		return errors.New("--tls-server-secret and --tls-client-secret must be specified together")
+	case a.TlsServerSecret != "" && (a.TlsCaSecret != "" || a.CertManagerIssuer != ""):
+		return errors.New("pre-issued certificates cannot be combined with --tls-ca-secret or --cert-manager-issuer")
+	case a.TlsCaSecret != "" && a.CertManagerIssuer != "":
+		return errors.New("--tls-ca-secret and --cert-manager-issuer are mutually exclusive")
+	}
+	if a.externalCerts() {
+		// the ca.crt alongside the client cert+key is the only CA to trust
+		if err := k.Core.ConfigMap().Delete(k.Namespace, client.CABundleConfigMap, &metav1.DeleteOptions{}); err != nil && !apierr.IsNotFound(err) {
+			return err
+		}
+		if a.TlsServerSecret != "" {
+			return preIssuedCerts(ctx, k, a.TlsServerSecret, a.TlsClientSecret)
+		}
+		return a.certManagerCertificates(ctx, k)
+	}
+
 	// assert CA
+	if a.TlsCaSecret != "" {
+		if err := copyTLSSecret(k, a.TlsCaSecret, "kim-tls-ca", false); err != nil {
+			return errors.Wrap(err, "failed to assert certificate authority")
+		}
+	}
 	caCert, caKey, err := client.LoadOrGenCA(secrets, k.Namespace, "kim-tls-ca")
 	if err != nil {
 		return errors.Wrap(err, "failed to assert certificate authority")
 	}
-	nodeList, err := k.Core.Node().List(metav1.ListOptions{
-		LabelSelector: "node-role.kubernetes.io/builder==true",
-	})
-	if err != nil {
-		return err
-	}
-
-	ips := []net.IP{}
-	domains := []string{
-		fmt.Sprintf("builder.%s.svc", k.Namespace),
+	if dueForRotation(caCert) {
+		logrus.Warnf("Certificate authority expires %s, rotate it with `kim builder certs rotate --ca`", expires(caCert))
 	}
-	if endpointIP := net.ParseIP(a.EndpointAddr); endpointIP != nil {
-		ips = append(ips, endpointIP)
-	} else if a.EndpointAddr != "" {
-		domains = append(domains, a.EndpointAddr)
+	caBundle, err := publishCABundle(k)
+	if err != nil {
+		return errors.Wrap(err, "failed to publish certificate authority")
 	}
-	for _, node := range nodeList.Items {
-		for _, addr := range node.Status.Addresses {
-			switch addr.Type {
-			case corev1.NodeInternalIP:
-				ips = append(ips, net.ParseIP(addr.Address))
-			case corev1.NodeHostName:
-				domains = append(domains, addr.Address)
-			}
-		}
+	domains, ips, err := serverNames(k, a.EndpointAddr)
+	if err != nil {
+		return err
 	}
-	// assert server cert+key
-	_, _, err = client.LoadOrGenServerCert(secrets, k.Namespace, "kim-tls-server", caCert, caKey, "kube-image-server", nil, domains, ips)
+	// assert server cert+key, rotating it when close to expiry or issued by another CA
+	rotated := false
+	serverCert, _, err := client.LoadOrGenServerCert(secrets, k.Namespace, "kim-tls-server", caCert, caKey, "kube-image-server", nil, domains, ips)
 	if err != nil {
 		return errors.Wrap(err, "failed to assert server cert+key")
 	}
-	// assert client cert+key
-	_, _, err = client.LoadOrGenClientCert(secrets, k.Namespace, "kim-tls-client", caCert, caKey, "kube-image-client")
+	if reason := rotationReason(serverCert, caCert); reason != "" {
+		logrus.Infof("Server certificate %s, rotating", reason)
+		if _, _, err = client.RotateServerCert(secrets, k.Namespace, "kim-tls-server", caCert, caKey, "kube-image-server", nil, domains, ips); err != nil {
+			return errors.Wrap(err, "failed to rotate server cert+key")
+		}
+		rotated = true
+	}
+	// assert client cert+key, rotating it when close to expiry or issued by another CA
+	clientCert, _, err := client.LoadOrGenClientCert(secrets, k.Namespace, "kim-tls-client", caCert, caKey, "kube-image-client", caBundle)
 	if err != nil {
 		return errors.Wrap(err, "failed to assert client cert+key")
 	}
+	if reason := rotationReason(clientCert, caCert); reason != "" {
+		logrus.Infof("Client certificate %s, rotating", reason)
+		if _, _, err = client.RotateClientCert(secrets, k.Namespace, "kim-tls-client", caCert, caKey, "kube-image-client", caBundle); err != nil {
+			return errors.Wrap(err, "failed to rotate client cert+key")
+		}
+		rotated = true
+	}
+	if rotated {
+		return restartBuilder(ctx, k)
+	}
 	return nil
 }
 
@@ -205,13 +259,12 @@ func (a *Install) Service(_ context.Context, k *client.Interface) error {
 					Annotations: labels.Set{},
 				},
 				Spec: corev1.ServiceSpec{
-					Type: corev1.ServiceTypeNodePort,
+					Type: corev1.ServiceTypeClusterIP,
 					Selector: labels.Set{
 						"app.kubernetes.io/name":      "kim",
 						"app.kubernetes.io/component": "builder",
 					},
 					Ports: []corev1.ServicePort{
-						a.servicePort("buildkit"),
 						a.servicePort("kim"),
 					},
 				},
@@ -234,11 +287,200 @@ func (a *Install) Service(_ context.Context, k *client.Interface) error {
 		if a.EndpointAddr != "" {
 			svc.Annotations["images.cattle.io/endpoint-override"] = a.EndpointAddr
 		}
+		// clients reach the builder through port-forwards or the endpoints directly, never by node port
+		if svc.Spec.Type == corev1.ServiceTypeNodePort {
+			svc.Spec.Type = corev1.ServiceTypeClusterIP
+			for i := range svc.Spec.Ports {
+				svc.Spec.Ports[i].NodePort = 0
+			}
+		}
+		// only the agent is exposed, buildkitd is reached through it
+		var ports []corev1.ServicePort
+		for _, port := range svc.Spec.Ports {
+			if port.Name != "buildkit" {
+				ports = append(ports, port)
+			}
+		}
+		svc.Spec.Ports = ports
 		svc, err = k.Core.Service().Update(svc)
 		return err
 	})
 }
 
+// RBAC asserts, with token auth, the service account that the agent reviews tokens and requests as, bound to the
+// system:auth-delegator cluster role.
+func (a *Install) RBAC(_ context.Context, k *client.Interface) error {
+	if !a.TokenAuth {
+		return nil
+	}
+	logrus.Info("Asserting service account for token auth")
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		sa, err := k.Core.ServiceAccount().Get(k.Namespace, "builder", metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			sa = &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "builder",
+					Namespace: k.Namespace,
+					Labels: labels.Set{
+						"app.kubernetes.io/managed-by": "kim",
+					},
+				},
+			}
+			_, err = k.Core.ServiceAccount().Create(sa)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	name := TokenAuthBindingName(k)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		binding := &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: labels.Set{
+					"app.kubernetes.io/managed-by": "kim",
+				},
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     "system:auth-delegator",
+			},
+			Subjects: []rbacv1.Subject{{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      "builder",
+				Namespace: k.Namespace,
+			}},
+		}
+		existing, err := k.RBAC.ClusterRoleBinding().Get(name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			_, err = k.RBAC.ClusterRoleBinding().Create(binding)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		existing.Subjects = binding.Subjects
+		_, err = k.RBAC.ClusterRoleBinding().Update(existing)
+		return err
+	})
+}
+
+// TokenAuthBindingName is the name of the cluster role binding that lets the agent review tokens and requests.
+func TokenAuthBindingName(k *client.Interface) string {
+	return "kim-builder-" + k.Namespace
+}
+
+// RegistriesConfig asserts the kim-specific registries config if one has been specified.
+func (a *Install) RegistriesConfig(_ context.Context, k *client.Interface) error {
+	if a.Force {
+		deletePropagation := metav1.DeletePropagationBackground
+		deleteOptions := metav1.DeleteOptions{
+			PropagationPolicy: &deletePropagation,
+		}
+		k.Core.ConfigMap().Delete(k.Namespace, "kim-registries", &deleteOptions)
+	}
+	if a.Registries == "" {
+		return nil
+	}
+	logrus.Info("Asserting registries config")
+	data, err := ioutil.ReadFile(a.Registries)
+	if err != nil {
+		return errors.Wrap(err, "failed to read registries config")
+	}
+	if _, err = registries.Parse(data); err != nil {
+		return err
+	}
+	return applyConfigMap(k, "kim-registries", map[string]string{
+		"registries.yaml": string(data),
+	})
+}
+
+// BuildkitConfig asserts the buildkitd configuration if a GC policy or registries config has been specified.
+func (a *Install) BuildkitConfig(_ context.Context, k *client.Interface) error {
+	if a.Force {
+		deletePropagation := metav1.DeletePropagationBackground
+		deleteOptions := metav1.DeleteOptions{
+			PropagationPolicy: &deletePropagation,
+		}
+		k.Core.ConfigMap().Delete(k.Namespace, "buildkitd-config", &deleteOptions)
+	}
+	if !a.buildkitConfigured() {
+		return nil
+	}
+	logrus.Info("Asserting buildkitd config")
+	toml := buildkitdConfigHeader
+	if a.GcKeepDuration != "" || a.GcKeepStorage != "" {
+		keepDuration, keepStorage, err := parseKeep(a.GcKeepDuration, a.GcKeepStorage)
+		if err != nil {
+			return err
+		}
+		toml += fmt.Sprintf(buildkitdGCPolicyTemplate, int64(keepDuration.Seconds()), keepStorage)
+	}
+	if a.Registries != "" {
+		registryConfig, err := a.registryConfig()
+		if err != nil {
+			return err
+		}
+		toml += buildkitRegistryConfig(registryConfig)
+	}
+	return applyConfigMap(k, "buildkitd-config", map[string]string{
+		"buildkitd.toml": toml,
+	})
+}
+
+func (a *Install) buildkitConfigured() bool {
+	return a.GcKeepDuration != "" || a.GcKeepStorage != "" || a.Registries != ""
+}
+
+// registryConfig parses the kim-specific registries config.
+func (a *Install) registryConfig() (*registries.Registry, error) {
+	data, err := ioutil.ReadFile(a.Registries)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read registries config")
+	}
+	return registries.Parse(data)
+}
+
+// applyConfigMap creates, or updates, the kim-managed config map.
+func applyConfigMap(k *client.Interface, name string, data map[string]string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := k.Core.ConfigMap().Get(k.Namespace, name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: k.Namespace,
+					Labels: labels.Set{
+						"app.kubernetes.io/managed-by": "kim",
+					},
+				},
+				Data: data,
+			}
+			cm, err = k.Core.ConfigMap().Create(cm)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		cm.Data = data
+		cm, err = k.Core.ConfigMap().Update(cm)
+		return err
+	})
+}
+
+const buildkitdConfigHeader = `[worker.containerd]
+  gc = true
+`
+
+// a single policy for the containerd worker, zero values meaning no limit
+const buildkitdGCPolicyTemplate = `
+  [[worker.containerd.gcpolicy]]
+    keepDuration = %d
+    keepBytes = %d
+`
+
 func (a *Install) DaemonSet(_ context.Context, k *client.Interface) error {
 	logrus.Info("Installing builder daemon")
 	if a.Force {
@@ -258,6 +500,10 @@ func (a *Install) DaemonSet(_ context.Context, k *client.Interface) error {
 	if err != nil {
 		return err
 	}
+	certsHash, err := tlsHash(k)
+	if err != nil {
+		return err
+	}
 	buildkitProbe := corev1.Probe{
 		Handler: corev1.Handler{
 			Exec: &corev1.ExecAction{
@@ -299,6 +545,9 @@ func (a *Install) DaemonSet(_ context.Context, k *client.Interface) error {
 						"app.kubernetes.io/component":  "builder",
 						"app.kubernetes.io/managed-by": "kim",
 					},
+					Annotations: map[string]string{
+						tlsHashAnnotation: certsHash,
+					},
 				},
 				Spec: corev1.PodSpec{
 					HostNetwork: true,
@@ -359,17 +608,10 @@ func (a *Install) DaemonSet(_ context.Context, k *client.Interface) error {
 						Image: buildkitImage,
 						Args: []string{
 							fmt.Sprintf("--addr=%s", a.BuildkitSocket),
-							fmt.Sprintf("--addr=tcp://0.0.0.0:%d", a.BuildkitPort),
 							"--containerd-worker=true",
 							fmt.Sprintf("--containerd-worker-addr=%s", a.ContainerdSocket),
 							"--containerd-worker-gc",
 							"--oci-worker=false",
-							"--tlscacert=/certs/ca/tls.crt",
-							"--tlscert=/certs/server/tls.crt",
-							"--tlskey=/certs/server/tls.key",
-						},
-						Ports: []corev1.ContainerPort{
-							a.containerPort("buildkit"),
 						},
 						SecurityContext: &corev1.SecurityContext{
 							Privileged: &privileged,
@@ -380,8 +622,6 @@ func (a *Install) DaemonSet(_ context.Context, k *client.Interface) error {
 							{Name: "host-tmp", MountPath: "/tmp", MountPropagation: &mountPropagationBidirectional},
 							{Name: "host-var-lib-buildkit", MountPath: "/var/lib/buildkit", MountPropagation: &mountPropagationBidirectional},
 							{Name: "host-containerd", MountPath: a.ContainerdVolume, MountPropagation: &mountPropagationBidirectional},
-							{Name: "certs-ca", MountPath: "/certs/ca", ReadOnly: true},
-							{Name: "certs-server", MountPath: "/certs/server", ReadOnly: true},
 						},
 						ReadinessProbe: &buildkitProbe,
 						LivenessProbe:  &buildkitProbe,
@@ -392,12 +632,18 @@ func (a *Install) DaemonSet(_ context.Context, k *client.Interface) error {
 						Args: []string{
 							fmt.Sprintf("--agent-port=%d", a.AgentPort),
 							fmt.Sprintf("--buildkit-socket=%s", a.BuildkitSocket),
-							fmt.Sprintf("--buildkit-port=%d", a.BuildkitPort),
 							fmt.Sprintf("--containerd-socket=%s", a.ContainerdSocket),
+							fmt.Sprintf("--containerd-volume=%s", a.ContainerdVolume),
 							"--tlscacert=/certs/ca/tls.crt",
 							"--tlscert=/certs/server/tls.crt",
 							"--tlskey=/certs/server/tls.key",
 						},
+						Env: []corev1.EnvVar{{
+							Name: "NAMESPACE",
+							ValueFrom: &corev1.EnvVarSource{
+								FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"},
+							},
+						}},
 						Ports: []corev1.ContainerPort{
 							a.containerPort("kim"),
 						},
@@ -484,6 +730,96 @@ func (a *Install) DaemonSet(_ context.Context, k *client.Interface) error {
 			},
 		},
 	}
+	spec := &daemon.Spec.Template.Spec
+	if a.externalCerts() {
+		// externally issued certificates come with the ca.crt of their issuer rather than the kim CA
+		for c := range spec.Containers {
+			container := &spec.Containers[c]
+			for i, arg := range container.Args {
+				if arg == "--tlscacert=/certs/ca/tls.crt" {
+					container.Args[i] = "--tlscacert=/certs/server/" + client.CACertKey
+				}
+			}
+			var mounts []corev1.VolumeMount
+			for _, mount := range container.VolumeMounts {
+				if mount.Name != "certs-ca" {
+					mounts = append(mounts, mount)
+				}
+			}
+			container.VolumeMounts = mounts
+		}
+		var volumes []corev1.Volume
+		for _, volume := range spec.Volumes {
+			if volume.Name != "certs-ca" {
+				volumes = append(volumes, volume)
+			}
+		}
+		spec.Volumes = volumes
+	}
+	if a.TokenAuth {
+		spec.ServiceAccountName = "builder"
+		spec.Containers[1].Args = append(spec.Containers[1].Args, "--token-auth")
+	}
+	if a.buildkitConfigured() {
+		spec.Containers[0].Args = append(spec.Containers[0].Args, "--config=/etc/buildkit/buildkitd.toml")
+		spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts,
+			corev1.VolumeMount{Name: "buildkitd-config", MountPath: "/etc/buildkit", ReadOnly: true},
+		)
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: "buildkitd-config", VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "buildkitd-config"},
+				},
+			},
+		})
+	}
+	if a.Registries != "" {
+		registryConfig, err := a.registryConfig()
+		if err != nil {
+			return err
+		}
+		spec.Containers[1].Args = append(spec.Containers[1].Args, "--registries=/etc/kim/registries.yaml")
+		spec.Containers[1].VolumeMounts = append(spec.Containers[1].VolumeMounts,
+			corev1.VolumeMount{Name: "kim-registries", MountPath: "/etc/kim", ReadOnly: true},
+		)
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: "kim-registries", VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "kim-registries"},
+				},
+			},
+		})
+		// the TLS files are expected at the same paths on the builder node(s)
+		for i, dir := range registryDirs(registryConfig) {
+			name := fmt.Sprintf("registries-tls-%d", i)
+			for c := range spec.Containers {
+				spec.Containers[c].VolumeMounts = append(spec.Containers[c].VolumeMounts,
+					corev1.VolumeMount{Name: name, MountPath: dir, ReadOnly: true},
+				)
+			}
+			spec.Volumes = append(spec.Volumes, corev1.Volume{
+				Name: name, VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{
+						Path: dir, Type: &hostPathDirectory,
+					},
+				},
+			})
+		}
+	} else if a.ContainerdSocket == server.K3sContainerdSocket {
+		// only the registries.yaml of k3s is mounted, its directory also holds the admin kubeconfig
+		hostPathFileOrCreate := corev1.HostPathFileOrCreate
+		spec.Containers[1].Args = append(spec.Containers[1].Args, "--registries=/etc/kim/host-registries.yaml")
+		spec.Containers[1].VolumeMounts = append(spec.Containers[1].VolumeMounts,
+			corev1.VolumeMount{Name: "host-registries", MountPath: "/etc/kim/host-registries.yaml", ReadOnly: true},
+		)
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: "host-registries", VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: registries.DefaultFile, Type: &hostPathFileOrCreate,
+				},
+			},
+		})
+	}
 	_, err = k.Apps.DaemonSet().Create(daemon)
 	if apierr.IsAlreadyExists(err) {
 		return errors.Errorf("builder already installed")
@@ -491,7 +827,7 @@ func (a *Install) DaemonSet(_ context.Context, k *client.Interface) error {
 	return err
 }
 
-// NodeRole asserts that the node can run KIM and labels it with the builder role
+// NodeRole asserts that the selected node(s) can run KIM and labels them with the builder role
 func (a *Install) NodeRole(_ context.Context, k *client.Interface) error {
 	nodeList, err := k.Core.Node().List(metav1.ListOptions{
 		LabelSelector: a.Selector,
@@ -502,61 +838,73 @@ func (a *Install) NodeRole(_ context.Context, k *client.Interface) error {
 	if len(nodeList.Items) == 0 {
 		return errors.New("failed to select any nodes")
 	}
-	if len(nodeList.Items) == 1 {
-		logrus.Infof("Applying node-role `builder` to `%s`", nodeList.Items[0].Name)
-		return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-			node, err := k.Core.Node().Get(nodeList.Items[0].Name, metav1.GetOptions{})
+	if len(nodeList.Items) > 1 && a.EndpointAddr != "" {
+		return errors.New("the endpoint address override can only stand in for a single builder node")
+	}
+	if len(nodeList.Items) > 1 && a.Selector == "" {
+		label := "k3s.io/hostname"
+		if _, k3s := nodeList.Items[0].Labels[label]; !k3s {
+			label = "kubernetes.io/hostname"
+		}
+		return errors.Errorf("Too many nodes, please specify a selector, e.g. %s=%s", label, nodeList.Items[0].Name)
+	}
+	var detectedSocket, detectedVolume string
+	for _, item := range nodeList.Items {
+		logrus.Infof("Applying node-role `builder` to `%s`", item.Name)
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			node, err := k.Core.Node().Get(item.Name, metav1.GetOptions{})
 			if err != nil {
 				return err
 			}
-			// detect container runtime and adjust defaults
-			crv, err := url.Parse(node.Status.NodeInfo.ContainerRuntimeVersion)
+			socket, volume, err := containerRuntime(node)
 			if err != nil {
-				return errors.Wrap(err, "failed to parse container runtime version")
+				return errors.Wrapf(err, "node %s", node.Name)
 			}
-			switch {
-			// embedded containerd
-			case crv.Scheme == "containerd" && strings.Contains(crv.Host, "-k3s"):
-				if a.ContainerdSocket == "" {
-					a.ContainerdSocket = server.K3sContainerdSocket
-				}
-				if a.ContainerdVolume == "" {
-					a.ContainerdVolume = server.K3sContainerdVolume
-				}
-			// external containerd
-			case crv.Scheme == "containerd" /* && !strings.Contains(crv.Host, "-k3s") */ :
-				if a.ContainerdSocket == "" {
-					a.ContainerdSocket = server.StockContainerdSocket
-				}
-				if a.ContainerdVolume == "" {
-					a.ContainerdVolume = server.StockContainerdVolume
-				}
-			default:
-				return errors.Errorf("container runtime `%s` not supported", crv.Scheme)
+			if detectedSocket != "" && (detectedSocket != socket || detectedVolume != volume) {
+				return errors.Errorf("node %s: container runtime `%s` differs from that of other builder nodes", node.Name, node.Status.NodeInfo.ContainerRuntimeVersion)
 			}
+			detectedSocket, detectedVolume = socket, volume
 			node.Labels = labels.Merge(node.Labels, labels.Set{
 				"node-role.kubernetes.io/builder": "true",
 			})
 			_, err = k.Core.Node().Update(node)
 			return err
 		})
+		if err != nil {
+			return err
+		}
+	}
+	if a.ContainerdSocket == "" {
+		a.ContainerdSocket = detectedSocket
+	}
+	if a.ContainerdVolume == "" {
+		a.ContainerdVolume = detectedVolume
 	}
+	return nil
+}
 
-	label := "k3s.io/hostname"
-	if _, k3s := nodeList.Items[0].Labels[label]; !k3s {
-		label = "kubernetes.io/hostname"
+// containerRuntime detects the containerd socket and volume of the node. all builder nodes share the same daemonset
+// spec and so must agree on them.
+func containerRuntime(node *corev1.Node) (socket, volume string, err error) {
+	crv, err := url.Parse(node.Status.NodeInfo.ContainerRuntimeVersion)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to parse container runtime version")
 	}
-	return errors.Errorf("Too many nodes, please specify a selector, e.g. %s=%s", label, nodeList.Items[0].Name)
+	switch {
+	// embedded containerd
+	case crv.Scheme == "containerd" && strings.Contains(crv.Host, "-k3s"):
+		socket, volume = server.K3sContainerdSocket, server.K3sContainerdVolume
+	// external containerd
+	case crv.Scheme == "containerd" /* && !strings.Contains(crv.Host, "-k3s") */ :
+		socket, volume = server.StockContainerdSocket, server.StockContainerdVolume
+	default:
+		return "", "", errors.Errorf("container runtime `%s` not supported", crv.Scheme)
+	}
+	return socket, volume, nil
 }
 
 func (a *Install) containerPort(name string) corev1.ContainerPort {
 	switch name {
-	case "buildkit":
-		return corev1.ContainerPort{
-			Name:          name,
-			ContainerPort: int32(a.BuildkitPort),
-			Protocol:      corev1.ProtocolTCP,
-		}
 	case "kim":
 		return corev1.ContainerPort{
 			Name:          name,
@@ -570,12 +918,6 @@ func (a *Install) containerPort(name string) corev1.ContainerPort {
 
 func (a *Install) servicePort(name string) corev1.ServicePort {
 	switch name {
-	case "buildkit":
-		return corev1.ServicePort{
-			Name:     name,
-			Port:     int32(a.BuildkitPort),
-			Protocol: corev1.ProtocolTCP,
-		}
 	case "kim":
 		return corev1.ServicePort{
 			Name:     name,
@@ -586,3 +928,18 @@ func (a *Install) servicePort(name string) corev1.ServicePort {
 		return corev1.ServicePort{Name: name}
 	}
 }
+
+// registryDirs returns the distinct directories of the TLS files referenced by the registries config.
+func registryDirs(registry *registries.Registry) []string {
+	seen := map[string]bool{}
+	var dirs []string
+	for _, file := range registry.Files() {
+		dir := filepath.Dir(file)
+		if !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+	sort.Strings(dirs)
+	return dirs
+}
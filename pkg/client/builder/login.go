@@ -3,7 +3,16 @@ package builder
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	"github.com/docker/go-units"
+	"github.com/pkg/errors"
 	"github.com/rancher/kim/pkg/client"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -13,65 +22,206 @@ import (
 	"k8s.io/kubernetes/pkg/credentialprovider"
 )
 
+// the annotation on the kim-docker-config secret recording when the credentials for each server were last updated
+const loginUpdatedAnnotation = "images.cattle.io/login-updated"
+
 type Login struct {
 	Password      string `usage:"Password" short:"p"`
 	PasswordStdin bool   `usage:"Take the password from stdin"`
 	Username      string `usage:"Username" short:"u"`
+	List          bool   `usage:"List the registries that credentials have been established for, with redacted usernames"`
+	Shared        bool   `usage:"Establish credentials shared by all users, in the kim namespace, rather than those of the kubeconfig context namespace"`
+	Default       bool   `usage:"Allow keeping credentials in the default namespace, which is commonly used by several users, when that is the kubeconfig context namespace"`
 }
 
 func (s *Login) Do(_ context.Context, k *client.Interface, server string) error {
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		login, err := k.Core.Secret().Get(k.Namespace, "kim-docker-config", metav1.GetOptions{})
-		if apierr.IsNotFound(err) {
-			dockerConfigJSON := credentialprovider.DockerConfigJSON{
-				Auths: credentialprovider.DockerConfig{
-					server: {
-						Username: s.Username,
-						Password: s.Password,
-					},
-				},
-			}
-			dockerConfigJSONBytes, err := json.Marshal(&dockerConfigJSON)
-			if err != nil {
-				return err
+	namespace, err := credentialNamespace(k, s.Shared, s.Default)
+	if err != nil {
+		return err
+	}
+	return updateDockerConfig(k, namespace, func(auths credentialprovider.DockerConfig, updated map[string]string) error {
+		auths[server] = credentialprovider.DockerConfigEntry{
+			Username: s.Username,
+			Password: s.Password,
+		}
+		updated[server] = time.Now().UTC().Format(time.RFC3339)
+		return nil
+	})
+}
+
+// ListCredentials prints the servers with credentials, their redacted usernames, when they were last updated and the
+// namespace they are kept in, the caller's own before those that are shared.
+func (s *Login) ListCredentials(_ context.Context, k *client.Interface) error {
+	w := tabwriter.NewWriter(os.Stdout, 1, 8, 3, ' ', 0)
+	fmt.Fprintln(w, "SERVER\tUSERNAME\tUPDATED\tNAMESPACE")
+	namespaces := []string{k.Namespace}
+	if k.UserNamespace != "" && k.UserNamespace != k.Namespace {
+		namespaces = []string{k.UserNamespace, k.Namespace}
+	}
+	for _, namespace := range namespaces {
+		auths, updated, err := getDockerConfig(k, namespace)
+		if err != nil {
+			return err
+		}
+		servers := make([]string, 0, len(auths))
+		for server := range auths {
+			servers = append(servers, server)
+		}
+		sort.Strings(servers)
+		for _, server := range servers {
+			lastUpdated := ""
+			if t, err := time.Parse(time.RFC3339, updated[server]); err == nil {
+				lastUpdated = units.HumanDuration(time.Since(t)) + " ago"
 			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", server, redact(auths[server].Username), lastUpdated, namespace)
+		}
+	}
+	return w.Flush()
+}
+
+// Logout removes the credentials for a registry.
+type Logout struct {
+	Shared  bool `usage:"Remove credentials shared by all users, in the kim namespace, rather than those of the kubeconfig context namespace"`
+	Default bool `usage:"Allow removing credentials from the default namespace, when that is the kubeconfig context namespace"`
+}
+
+func (s *Logout) Do(_ context.Context, k *client.Interface, server string) error {
+	namespace, err := credentialNamespace(k, s.Shared, s.Default)
+	if err != nil {
+		return err
+	}
+	return updateDockerConfig(k, namespace, func(auths credentialprovider.DockerConfig, updated map[string]string) error {
+		if _, ok := auths[server]; !ok {
+			return errors.Errorf("not logged in to %s in namespace %s", server, namespace)
+		}
+		delete(auths, server)
+		delete(updated, server)
+		return nil
+	})
+}
+
+// NormalizeServer returns the key for the server in the docker config, with [*.]docker.io becoming
+// https://index.docker.io/v1/ and other servers reduced to their host.
+func NormalizeServer(arg string) (string, error) {
+	server, err := credentialprovider.ParseSchemelessURL(arg)
+	if err != nil {
+		if server, err = url.Parse(arg); err != nil {
+			return "", err
+		}
+	}
+	// special case for [*.]docker.io -> https://index.docker.io/v1/
+	if strings.HasSuffix(server.Host, "docker.io") {
+		server.Scheme = "https"
+		server.Host = "index.docker.io"
+		if server.Path == "" {
+			server.Path = "/v1/"
+		}
+		return server.String(), nil
+	}
+	return server.Host, nil
+}
+
+// credentialNamespace returns the namespace that the credentials of the caller are kept in, or the kim namespace for
+// those that are shared. The credentials of the caller are refused the kim namespace, where they would be shared, and
+// the default namespace, which is commonly used by several users, unless allowed.
+func credentialNamespace(k *client.Interface, shared, allowDefault bool) (string, error) {
+	switch {
+	case shared:
+		return k.Namespace, nil
+	case k.UserNamespace == "" || k.UserNamespace == k.Namespace:
+		return "", errors.Errorf("credentials in the kim namespace %s are shared by all users, use --shared or a kubeconfig context with a namespace of your own", k.Namespace)
+	case k.UserNamespace == metav1.NamespaceDefault && !allowDefault:
+		return "", errors.Errorf("credentials in the %s namespace are readable by all users with access to it, use --default or a kubeconfig context with a namespace of your own", metav1.NamespaceDefault)
+	}
+	return k.UserNamespace, nil
+}
+
+// redact all but the first and last characters of longer usernames
+func redact(username string) string {
+	if len(username) <= 4 {
+		return strings.Repeat("*", len(username))
+	}
+	return username[:1] + strings.Repeat("*", len(username)-2) + username[len(username)-1:]
+}
+
+// getDockerConfig returns the credentials in the namespace, by server, and when they were last updated.
+func getDockerConfig(k *client.Interface, namespace string) (credentialprovider.DockerConfig, map[string]string, error) {
+	login, err := k.Core.Secret().Get(namespace, client.DockerConfigSecret, metav1.GetOptions{})
+	if apierr.IsNotFound(err) || apierr.IsForbidden(err) {
+		return credentialprovider.DockerConfig{}, map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return parseDockerConfig(login)
+}
+
+func parseDockerConfig(login *corev1.Secret) (credentialprovider.DockerConfig, map[string]string, error) {
+	dockerConfigJSON := credentialprovider.DockerConfigJSON{}
+	if dockerConfigJSONBytes, ok := login.Data[corev1.DockerConfigJsonKey]; ok {
+		if err := json.Unmarshal(dockerConfigJSONBytes, &dockerConfigJSON); err != nil {
+			return nil, nil, err
+		}
+	}
+	if dockerConfigJSON.Auths == nil {
+		dockerConfigJSON.Auths = credentialprovider.DockerConfig{}
+	}
+	updated := map[string]string{}
+	if annotation, ok := login.Annotations[loginUpdatedAnnotation]; ok {
+		if err := json.Unmarshal([]byte(annotation), &updated); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse %s annotation", loginUpdatedAnnotation)
+		}
+	}
+	return dockerConfigJSON.Auths, updated, nil
+}
+
+// updateDockerConfig applies fn to the credentials in the kim-docker-config secret of the namespace, creating it if
+// necessary.
+func updateDockerConfig(k *client.Interface, namespace string, fn func(auths credentialprovider.DockerConfig, updated map[string]string) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		login, err := k.Core.Secret().Get(namespace, client.DockerConfigSecret, metav1.GetOptions{})
+		create := apierr.IsNotFound(err)
+		if create {
 			login = &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "kim-docker-config",
-					Namespace: k.Namespace,
+					Name:      client.DockerConfigSecret,
+					Namespace: namespace,
 					Labels: labels.Set{
 						"app.kubernetes.io/managed-by": "kim",
 					},
 				},
-				Type: corev1.SecretTypeDockerConfigJson,
-				Data: map[string][]byte{
-					corev1.DockerConfigJsonKey: dockerConfigJSONBytes,
-				},
 			}
-			_, err = k.Core.Secret().Create(login)
+		} else if err != nil {
 			return err
 		}
-		dockerConfigJSON := credentialprovider.DockerConfigJSON{
-			Auths: credentialprovider.DockerConfig{},
-		}
-		if dockerConfigJSONBytes, ok := login.Data[corev1.DockerConfigJsonKey]; ok {
-			if err := json.Unmarshal(dockerConfigJSONBytes, &dockerConfigJSON); err != nil {
-				return err
-			}
+		auths, updated, err := parseDockerConfig(login)
+		if err != nil {
+			return err
 		}
-		if dockerConfigJSON.Auths == nil {
-			dockerConfigJSON.Auths = credentialprovider.DockerConfig{}
+		if err = fn(auths, updated); err != nil {
+			return err
 		}
-		dockerConfigJSON.Auths[server] = credentialprovider.DockerConfigEntry{
-			Username: s.Username,
-			Password: s.Password,
+		dockerConfigJSONBytes, err := json.Marshal(&credentialprovider.DockerConfigJSON{Auths: auths})
+		if err != nil {
+			return err
 		}
-		dockerConfigJSONBytes, err := json.Marshal(&dockerConfigJSON)
+		updatedBytes, err := json.Marshal(updated)
 		if err != nil {
 			return err
 		}
+		if login.Annotations == nil {
+			login.Annotations = map[string]string{}
+		}
+		login.Annotations[loginUpdatedAnnotation] = string(updatedBytes)
+		if login.Data == nil {
+			login.Data = map[string][]byte{}
+		}
 		login.Type = corev1.SecretTypeDockerConfigJson
 		login.Data[corev1.DockerConfigJsonKey] = dockerConfigJSONBytes
+		if create {
+			_, err = k.Core.Secret().Create(login)
+			return err
+		}
 		_, err = k.Core.Secret().Update(login)
 		return err
 	})
@@ -0,0 +1,79 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/go-units"
+	controlapi "github.com/moby/buildkit/api/services/control"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+)
+
+// Prune the builder cache.
+type Prune struct {
+	All          bool     `usage:"Include internal/frontend cache records and those still referenced" short:"a"`
+	Filter       []string `usage:"Filter cache records to prune, e.g. type=regular" short:"f"`
+	KeepDuration string   `usage:"Keep cache records used more recently than this, e.g. 24h"`
+	KeepStorage  string   `usage:"Keep this much of the cache, e.g. 10GB"`
+	Verbose      bool     `usage:"Show the pruned cache records" short:"v"`
+}
+
+func (s *Prune) Do(ctx context.Context, k *client.Interface) error {
+	keepDuration, keepStorage, err := parseKeep(s.KeepDuration, s.KeepStorage)
+	if err != nil {
+		return err
+	}
+	req := &controlapi.PruneRequest{
+		Filter:       s.Filter,
+		All:          s.All,
+		KeepDuration: int64(keepDuration),
+		KeepBytes:    keepStorage,
+	}
+
+	var reclaimed int64
+	err = client.ImagesEach(ctx, k, func(ctx context.Context, node string, imagesClient imagesv1.ImagesClient) error {
+		stream, err := imagesClient.CachePrune(ctx, req)
+		if err != nil {
+			return err
+		}
+		for {
+			du, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if s.Verbose {
+				fmt.Printf("Deleted: %s (%s, %s)\n", du.ID, node, units.HumanSize(float64(du.Size_)))
+			}
+			if !du.Shared {
+				reclaimed += du.Size_
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Total reclaimed space: %s\n", units.HumanSize(float64(reclaimed)))
+	return nil
+}
+
+// parseKeep parses the human-readable duration and size of cache to keep, empty meaning no limit.
+func parseKeep(duration, storage string) (keepDuration time.Duration, keepStorage int64, err error) {
+	if duration != "" {
+		if keepDuration, err = time.ParseDuration(duration); err != nil {
+			return 0, 0, errors.Wrap(err, "failed to parse keep duration")
+		}
+	}
+	if storage != "" {
+		if keepStorage, err = units.RAMInBytes(storage); err != nil {
+			return 0, 0, errors.Wrap(err, "failed to parse keep storage")
+		}
+	}
+	return keepDuration, keepStorage, nil
+}
@@ -0,0 +1,102 @@
+package builder
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/kim/pkg/registries"
+	"github.com/sirupsen/logrus"
+)
+
+// buildkitRegistryConfig translates the registries config to the registry sections of buildkitd.toml. Buildkit only
+// supports mirrors by host, without paths, rewrites or a "*" wildcard.
+func buildkitRegistryConfig(registry *registries.Registry) string {
+	type section struct {
+		mirrors  []string
+		http     bool
+		insecure bool
+		ca       string
+		cert     string
+		key      string
+	}
+	sections := map[string]*section{}
+	get := func(host string) *section {
+		if s, ok := sections[host]; ok {
+			return s
+		}
+		s := &section{}
+		sections[host] = s
+		return s
+	}
+	for host, mirror := range registry.Mirrors {
+		if host == "*" {
+			logrus.Warn("Buildkit does not support mirrors for all registries (\"*\"), ignoring")
+			continue
+		}
+		if len(mirror.Rewrites) > 0 {
+			logrus.Warnf("Buildkit does not support rewrites, ignoring those of the %s mirror", host)
+		}
+		for _, endpoint := range mirror.Endpoints {
+			if !strings.Contains(endpoint, "://") {
+				endpoint = "https://" + endpoint
+			}
+			u, err := url.Parse(endpoint)
+			if err != nil {
+				logrus.Warnf("Ignoring invalid endpoint %q of the %s mirror: %v", endpoint, host, err)
+				continue
+			}
+			if path := strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/v2"); path != "" {
+				logrus.Warnf("Buildkit does not support mirror paths, ignoring endpoint %q of the %s mirror", endpoint, host)
+				continue
+			}
+			get(host).mirrors = append(get(host).mirrors, u.Host)
+			if u.Scheme == "http" {
+				get(u.Host).http = true
+			}
+		}
+	}
+	for host, config := range registry.Configs {
+		if config.TLS == nil {
+			continue
+		}
+		s := get(host)
+		s.insecure = config.TLS.InsecureSkipVerify
+		s.ca = config.TLS.CAFile
+		s.cert = config.TLS.CertFile
+		s.key = config.TLS.KeyFile
+	}
+
+	hosts := make([]string, 0, len(sections))
+	for host := range sections {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	var b strings.Builder
+	for _, host := range hosts {
+		s := sections[host]
+		fmt.Fprintf(&b, "\n[registry.%s]\n", strconv.Quote(host))
+		if len(s.mirrors) > 0 {
+			quoted := make([]string, len(s.mirrors))
+			for i, mirror := range s.mirrors {
+				quoted[i] = strconv.Quote(mirror)
+			}
+			fmt.Fprintf(&b, "  mirrors = [%s]\n", strings.Join(quoted, ", "))
+		}
+		if s.http {
+			b.WriteString("  http = true\n")
+		}
+		if s.insecure {
+			b.WriteString("  insecure = true\n")
+		}
+		if s.ca != "" {
+			fmt.Fprintf(&b, "  ca = [%s]\n", strconv.Quote(s.ca))
+		}
+		if s.cert != "" && s.key != "" {
+			fmt.Fprintf(&b, "  [[registry.%s.keypair]]\n    key = %s\n    cert = %s\n", strconv.Quote(host), strconv.Quote(s.key), strconv.Quote(s.cert))
+		}
+	}
+	return b.String()
+}
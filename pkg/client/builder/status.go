@@ -0,0 +1,292 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/containerd/containerd/platforms"
+	"github.com/docker/go-units"
+	controlapi "github.com/moby/buildkit/api/services/control"
+	bktypes "github.com/moby/buildkit/api/types"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// statusTimeout bounds each of the connectivity checks
+const statusTimeout = 10 * time.Second
+
+// Status reports on the health of the builder: its nodes and pods, the versions of the agent, buildkitd and
+// containerd, the usage of their volumes, whether the agent and buildkitd behind it answer and the certificates.
+type Status struct {
+}
+
+// nodeStatus is what is known of the builder on a particular node
+type nodeStatus struct {
+	pod           *corev1.Pod
+	info          *imagesv1.InfoResponse
+	workers       []*bktypes.WorkerRecord
+	agentCheck    *endpointCheck
+	buildkitCheck *endpointCheck
+}
+
+// endpointCheck is the outcome of calling an endpoint
+type endpointCheck struct {
+	address string
+	latency time.Duration
+	err     error
+}
+
+func (s *Status) Do(ctx context.Context, k *client.Interface) error {
+	daemon, err := k.Apps.DaemonSet().Get(k.Namespace, "builder", metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return errors.Errorf("builder is not installed in namespace %s, see `kim builder install`", k.Namespace)
+	}
+	if err != nil {
+		return err
+	}
+	healthy := daemon.Status.NumberReady > 0 && daemon.Status.NumberReady == daemon.Status.DesiredNumberScheduled
+	fmt.Printf("DaemonSet %s/builder: %d desired, %d ready, %d available, %d up-to-date\n",
+		k.Namespace, daemon.Status.DesiredNumberScheduled, daemon.Status.NumberReady, daemon.Status.NumberAvailable, daemon.Status.UpdatedNumberScheduled)
+	transport := k.Transport
+	if transport == "" {
+		transport = client.TransportPortForward
+	}
+	fmt.Printf("Transport: %s\n\n", transport)
+
+	nodes, err := s.nodes(ctx, k)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for name, node := range nodes {
+		names = append(names, name)
+		if node.pod == nil || !podReady(node.pod) || node.agentCheck == nil || node.agentCheck.err != nil ||
+			node.buildkitCheck == nil || node.buildkitCheck.err != nil {
+			healthy = false
+		}
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(os.Stdout, 1, 8, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tPOD\tPHASE\tREADY\tRESTARTS\tAGE")
+	for _, name := range names {
+		pod := nodes[name].pod
+		if pod == nil {
+			fmt.Fprintf(w, "%s\t<none>\t\t\t\t\n", name)
+			continue
+		}
+		ready, restarts := 0, int32(0)
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Ready {
+				ready++
+			}
+			restarts += status.RestartCount
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%d\t%s\n", name, pod.Name, pod.Status.Phase, ready, len(pod.Spec.Containers), restarts,
+			units.HumanDuration(time.Since(pod.CreationTimestamp.Time)))
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "NODE\tAGENT\tPLATFORM\tCONTAINERD\tCONTAINERD SOCKET\tBUILDKIT\tWORKERS")
+	for _, name := range names {
+		node := nodes[name]
+		agent, platform, containerd, socket := "unknown", "", "", ""
+		if info := node.info; info != nil {
+			agent = fmt.Sprintf("%s (%s)", info.Version, info.GitCommit)
+			platform = info.Platform
+			containerd = info.ContainerdVersion
+			socket = info.ContainerdSocket
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", name, agent, platform, containerd, socket, buildkitImage(node.pod), workers(node.workers))
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "NODE\tVOLUME\tPATH\tUSED\tAVAILABLE\tTOTAL")
+	for _, name := range names {
+		if nodes[name].info == nil {
+			continue
+		}
+		for _, volume := range nodes[name].info.Volumes {
+			if volume.Error != "" {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\t\n", name, volume.Name, volume.Path, volume.Error)
+				continue
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s (%.0f%%)\t%s\t%s\n", name, volume.Name, volume.Path,
+				units.HumanSize(float64(volume.Used)), percent(volume.Used, volume.Total),
+				units.HumanSize(float64(volume.Available)), units.HumanSize(float64(volume.Total)))
+		}
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "NODE\tENDPOINT\tADDRESS\tSTATUS\tLATENCY")
+	for _, name := range names {
+		for _, check := range []struct {
+			endpoint string
+			*endpointCheck
+		}{
+			{"kim", nodes[name].agentCheck},
+			{"buildkit (via kim)", nodes[name].buildkitCheck},
+		} {
+			switch {
+			case check.endpointCheck == nil:
+				fmt.Fprintf(w, "%s\t%s\t\tnot ready\t\n", name, check.endpoint)
+			case check.err != nil:
+				fmt.Fprintf(w, "%s\t%s\t%s\t%v\t\n", name, check.endpoint, check.address, check.err)
+			default:
+				fmt.Fprintf(w, "%s\t%s\t%s\tok\t%s\n", name, check.endpoint, check.address, check.latency.Round(time.Millisecond))
+			}
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	if err = (&CertsStatus{}).Do(ctx, k); err != nil {
+		return err
+	}
+	if !healthy {
+		return errors.New("builder is unhealthy")
+	}
+	return nil
+}
+
+// nodes returns the status of the builder on each node with the builder role, or only the targeted node, including
+// nodes that run a builder pod without the role.
+func (s *Status) nodes(ctx context.Context, k *client.Interface) (map[string]*nodeStatus, error) {
+	nodes := map[string]*nodeStatus{}
+	nodeList, err := k.Core.Node().List(metav1.ListOptions{
+		LabelSelector: "node-role.kubernetes.io/builder==true",
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodeList.Items {
+		if k.Node == "" || k.Node == node.Name {
+			nodes[node.Name] = &nodeStatus{}
+		}
+	}
+	podList, err := k.Core.Pod().List(k.Namespace, metav1.ListOptions{
+		LabelSelector: "app=kim,component=builder",
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		name := pod.Spec.NodeName
+		if name == "" || (k.Node != "" && k.Node != name) {
+			continue
+		}
+		if nodes[name] == nil {
+			nodes[name] = &nodeStatus{}
+		}
+		nodes[name].pod = pod
+	}
+
+	// buildkitd only listens on its local socket, so it is checked through the agent
+	if endpoints, err := client.GetServiceEndpoints(ctx, k, "kim"); err == nil {
+		for _, endpoint := range endpoints {
+			node, ok := nodes[endpoint.Node]
+			if !ok {
+				continue
+			}
+			node.agentCheck = checkEndpoint(ctx, endpoint, func(ctx context.Context) error {
+				return client.ImagesAt(ctx, k, endpoint, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+					info, err := imagesClient.Info(ctx, &imagesv1.InfoRequest{})
+					node.info = info
+					return err
+				})
+			})
+			if node.agentCheck.err != nil {
+				continue
+			}
+			node.buildkitCheck = checkEndpoint(ctx, endpoint, func(ctx context.Context) error {
+				return client.ImagesAt(ctx, k, endpoint, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+					res, err := imagesClient.Workers(ctx, &controlapi.ListWorkersRequest{})
+					if err == nil {
+						node.workers = res.Record
+					}
+					return err
+				})
+			})
+		}
+	}
+	return nodes, nil
+}
+
+func checkEndpoint(ctx context.Context, endpoint client.Endpoint, fn func(context.Context) error) *endpointCheck {
+	ctx, cancel := context.WithTimeout(ctx, statusTimeout)
+	defer cancel()
+	address := endpoint.Address
+	if endpoint.Pod != "" {
+		address = fmt.Sprintf("%s (%s)", address, endpoint.Pod)
+	}
+	start := time.Now()
+	err := fn(ctx)
+	return &endpointCheck{
+		address: address,
+		latency: time.Since(start),
+		err:     err,
+	}
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// buildkitImage returns the image that buildkitd runs, which is as close to a version as buildkitd reports.
+func buildkitImage(pod *corev1.Pod) string {
+	if pod == nil {
+		return "unknown"
+	}
+	for _, container := range pod.Spec.Containers {
+		if container.Name == "buildkit" {
+			return container.Image
+		}
+	}
+	return "unknown"
+}
+
+// workers returns the executors and platforms of the buildkit workers.
+func workers(workers []*bktypes.WorkerRecord) string {
+	var result []string
+	for _, worker := range workers {
+		var names []string
+		for _, platform := range worker.Platforms {
+			names = append(names, platforms.Format(specs.Platform{
+				OS:           platform.OS,
+				Architecture: platform.Architecture,
+				Variant:      platform.Variant,
+			}))
+		}
+		executor := worker.Labels["org.mobyproject.buildkit.worker.executor"]
+		if executor == "" {
+			executor = worker.ID
+		}
+		result = append(result, fmt.Sprintf("%s [%s]", executor, strings.Join(names, ",")))
+	}
+	return strings.Join(result, " ")
+}
+
+func percent(n, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) * 100 / float64(total)
+}
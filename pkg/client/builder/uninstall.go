@@ -62,6 +62,15 @@ func (a *Uninstall) Namespace(ctx context.Context, k *client.Interface) error {
 	}
 }
 
+// RBAC removes the cluster role binding that lets the agent review tokens and requests, if any.
+func (a *Uninstall) RBAC(_ context.Context, k *client.Interface) error {
+	err := k.RBAC.ClusterRoleBinding().Delete(TokenAuthBindingName(k), &metav1.DeleteOptions{})
+	if apierr.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
 // NodeRole removes the builder role from all nodes with that role.
 func (a *Uninstall) NodeRole(_ context.Context, k *client.Interface) error {
 	nodeList, err := k.Core.Node().List(metav1.ListOptions{
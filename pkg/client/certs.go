@@ -1,18 +1,40 @@
 package client
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/x509"
 	"net"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/rancher/kim/pkg/cert"
 	corectlv1 "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/retry"
 )
 
+// CACertKey is the key of the issuing CA's certificate in secrets written by cert-manager, which pre-issued certificates
+// are expected to follow, as do the client certificates issued by kim.
+const CACertKey = "ca.crt"
+
+// CABundleConfigMap is the config map in the builder namespace with the ca.crt of the kim CA(s), without their key,
+// for clients whose ca.crt predates a rotation of the CA.
+const CABundleConfigMap = "kim-ca-bundle"
+
+// LoadCA returns the cert+key of the CA, which must exist.
+func LoadCA(secrets corectlv1.SecretClient, namespace, name string) (*x509.Certificate, crypto.Signer, error) {
+	secret, err := secrets.Get(namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert.Unmarshal(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+}
+
 func LoadOrGenCA(secrets corectlv1.SecretClient, namespace, name string) (*x509.Certificate, crypto.Signer, error) {
 	secret, err := secrets.Get(namespace, name, metav1.GetOptions{})
 	if apierr.IsNotFound(err) {
@@ -24,10 +46,16 @@ func LoadOrGenCA(secrets corectlv1.SecretClient, namespace, name string) (*x509.
 	return cert.Unmarshal(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
 }
 
-func LoadOrGenClientCert(secrets corectlv1.SecretClient, namespace, name string, issuer *x509.Certificate, signer crypto.Signer, cn string) (*x509.Certificate, crypto.Signer, error) {
+// LoadOrGenClientCert returns the client cert+key, generating them if need be, and keeps the ca.crt alongside them up
+// to date with the PEM bundle of the CA.
+func LoadOrGenClientCert(secrets corectlv1.SecretClient, namespace, name string, issuer *x509.Certificate, signer crypto.Signer, cn string, ca []byte) (*x509.Certificate, crypto.Signer, error) {
 	secret, err := secrets.Get(namespace, name, metav1.GetOptions{})
 	if apierr.IsNotFound(err) {
-		secret, err = createAndStoreCert(secrets, namespace, name, cn, issuer, signer, cert.NewSignedClientCert)
+		secret, err = createAndStoreCert(secrets, namespace, name, cn, issuer, signer, cert.NewSignedClientCert, ca)
+	} else if err == nil && !bytes.Equal(secret.Data[CACertKey], ca) {
+		secret = secret.DeepCopy()
+		secret.Data[CACertKey] = ca
+		secret, err = secrets.Update(secret)
 	}
 	if err != nil {
 		return nil, nil, err
@@ -38,8 +66,95 @@ func LoadOrGenClientCert(secrets corectlv1.SecretClient, namespace, name string,
 func LoadOrGenServerCert(secrets corectlv1.SecretClient, namespace, name string, issuer *x509.Certificate, signer crypto.Signer, cn string, orgs, domains []string, ips []net.IP) (*x509.Certificate, crypto.Signer, error) {
 	secret, err := secrets.Get(namespace, name, metav1.GetOptions{})
 	if apierr.IsNotFound(err) {
-		secret, err = createAndStoreCert(secrets, namespace, name, cn, issuer, signer, cert.NewSignedCertFunc(orgs, domains, ips))
+		secret, err = createAndStoreCert(secrets, namespace, name, cn, issuer, signer, cert.NewSignedCertFunc(orgs, domains, ips), nil)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert.Unmarshal(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+}
+
+// LoadCerts returns the certificates in the secret, the first being that of its key.
+func LoadCerts(secrets corectlv1.SecretClient, namespace, name string) ([]*x509.Certificate, error) {
+	secret, err := secrets.Get(namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return certutil.ParseCertsPEM(secret.Data[corev1.TLSCertKey])
+}
+
+// LoadCABundle returns the PEM bundle of the CA(s) that the builder certificates are issued by: the ca.crt alongside
+// the client cert+key, followed by that of the kim-ca-bundle config map, if readable. It never reads the secret of
+// the kim CA, which holds its key.
+func LoadCABundle(k8s *Interface, clientCert *corev1.Secret) ([]byte, error) {
+	var bundle []byte
+	if clientCert != nil && len(clientCert.Data[CACertKey]) > 0 {
+		bundle = append(bundle, clientCert.Data[CACertKey]...)
+		bundle = append(bundle, '\n')
+	}
+	cm, err := k8s.Core.ConfigMap().Get(k8s.Namespace, CABundleConfigMap, metav1.GetOptions{})
+	if err == nil {
+		bundle = append(bundle, cm.Data[CACertKey]...)
+	} else if !apierr.IsNotFound(err) && !apierr.IsForbidden(err) {
+		return nil, err
 	}
+	if len(bytes.TrimSpace(bundle)) == 0 {
+		return nil, errors.Errorf("neither the client cert+key nor the %s config map carry %s", CABundleConfigMap, CACertKey)
+	}
+	return bundle, nil
+}
+
+// LoadClientCert returns the secret with the client cert+key to connect to the builder with: the caller's own, issued
+// by `kim builder certs issue` into their UserNamespace, or else the one shared by all users.
+func LoadClientCert(k8s *Interface) (*corev1.Secret, error) {
+	if k8s.UserNamespace != "" && k8s.UserNamespace != k8s.Namespace {
+		secret, err := k8s.Core.Secret().Get(k8s.UserNamespace, "kim-tls-client", metav1.GetOptions{})
+		if err == nil {
+			return secret, nil
+		}
+		if !apierr.IsNotFound(err) && !apierr.IsForbidden(err) {
+			return nil, err
+		}
+	}
+	return k8s.Core.Secret().Get(k8s.Namespace, "kim-tls-client", metav1.GetOptions{})
+}
+
+// RotateCA replaces the certificate authority with a new one. The previous is kept in the bundle, unless it has
+// expired, so that the certificates it signed remain trusted until they have been rotated in turn.
+func RotateCA(secrets corectlv1.SecretClient, namespace, name string) (*x509.Certificate, crypto.Signer, error) {
+	var bundle []byte
+	if previous, err := LoadCerts(secrets, namespace, name); err == nil && len(previous) > 0 && time.Now().Before(previous[0].NotAfter) {
+		crtPem, err := certutil.EncodeCertificates(previous[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		bundle = crtPem
+	} else if err != nil && !apierr.IsNotFound(err) {
+		return nil, nil, err
+	}
+	ca, key, err := cert.NewCA(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err = marshalAndStoreCert(secrets, namespace, ca, key, name, bundle, nil); err != nil {
+		return nil, nil, err
+	}
+	return ca, key, nil
+}
+
+// RotateClientCert replaces the client cert+key with a new pair signed by the issuer, along with the PEM bundle of the
+// CA as their ca.crt.
+func RotateClientCert(secrets corectlv1.SecretClient, namespace, name string, issuer *x509.Certificate, signer crypto.Signer, cn string, ca []byte) (*x509.Certificate, crypto.Signer, error) {
+	secret, err := createAndStoreCert(secrets, namespace, name, cn, issuer, signer, cert.NewSignedClientCert, ca)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert.Unmarshal(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+}
+
+// RotateServerCert replaces the server cert+key with a new pair signed by the issuer.
+func RotateServerCert(secrets corectlv1.SecretClient, namespace, name string, issuer *x509.Certificate, signer crypto.Signer, cn string, orgs, domains []string, ips []net.IP) (*x509.Certificate, crypto.Signer, error) {
+	secret, err := createAndStoreCert(secrets, namespace, name, cn, issuer, signer, cert.NewSignedCertFunc(orgs, domains, ips), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -51,10 +166,10 @@ func createAndStoreCA(secrets corectlv1.SecretClient, namespace, name string) (*
 	if err != nil {
 		return nil, err
 	}
-	return marshalAndCreateCert(secrets, namespace, ca, key, name)
+	return marshalAndStoreCert(secrets, namespace, ca, key, name, nil, nil)
 }
 
-func createAndStoreCert(secrets corectlv1.SecretClient, namespace, name, cn string, issuer *x509.Certificate, signer crypto.Signer, fn cert.NewCertFunc) (*corev1.Secret, error) {
+func createAndStoreCert(secrets corectlv1.SecretClient, namespace, name, cn string, issuer *x509.Certificate, signer crypto.Signer, fn cert.NewCertFunc, ca []byte) (*corev1.Secret, error) {
 	key, err := cert.NewPrivateKey()
 	if err != nil {
 		return nil, err
@@ -65,27 +180,48 @@ func createAndStoreCert(secrets corectlv1.SecretClient, namespace, name, cn stri
 		return nil, err
 	}
 
-	return marshalAndCreateCert(secrets, namespace, crt, key, name)
+	return marshalAndStoreCert(secrets, namespace, crt, key, name, nil, ca)
 }
 
-func marshalAndCreateCert(secrets corectlv1.SecretClient, namespace string, crt *x509.Certificate, key crypto.Signer, name string) (*corev1.Secret, error) {
+// marshalAndStoreCert creates or updates the secret with the cert+key, appending the PEM bundle to the cert, and the
+// ca.crt if any.
+func marshalAndStoreCert(secrets corectlv1.SecretClient, namespace string, crt *x509.Certificate, key crypto.Signer, name string, bundle, ca []byte) (*corev1.Secret, error) {
 	crtPem, keyPem, err := cert.Marshal(crt, key)
 	if err != nil {
 		return nil, err
 	}
+	crtPem = append(crtPem, bundle...)
+	data := map[string][]byte{
+		corev1.TLSCertKey:       crtPem,
+		corev1.TLSPrivateKeyKey: keyPem,
+	}
+	if len(ca) > 0 {
+		data[CACertKey] = ca
+	}
 
-	return secrets.Create(&corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-			Labels: labels.Set{
-				"app.kubernetes.io/managed-by": "kim",
-			},
-		},
-		Data: map[string][]byte{
-			corev1.TLSCertKey:       crtPem,
-			corev1.TLSPrivateKeyKey: keyPem,
-		},
-		Type: corev1.SecretTypeTLS,
+	var result *corev1.Secret
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := secrets.Get(namespace, name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			result, err = secrets.Create(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels: labels.Set{
+						"app.kubernetes.io/managed-by": "kim",
+					},
+				},
+				Data: data,
+				Type: corev1.SecretTypeTLS,
+			})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		secret.Data = data
+		result, err = secrets.Update(secret)
+		return err
 	})
+	return result, err
 }
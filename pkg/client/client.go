@@ -5,6 +5,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 
 	"github.com/pkg/errors"
@@ -16,11 +17,12 @@ import (
 	rbacctl "github.com/rancher/wrangler/pkg/generated/controllers/rbac"
 	rbacctlv1 "github.com/rancher/wrangler/pkg/generated/controllers/rbac/v1"
 	"github.com/sirupsen/logrus"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	authenticationv1 "k8s.io/client-go/kubernetes/typed/authentication/v1"
+	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/kubernetes/pkg/credentialprovider"
-	"k8s.io/kubernetes/pkg/credentialprovider/secrets"
 )
 
 const (
@@ -32,24 +34,47 @@ var DefaultConfig = Config{
 }
 
 type Config struct {
-	Namespace  string `usage:"namespace" short:"n" env:"NAMESPACE" default:"kube-image"`
-	Kubeconfig string `usage:"kubeconfig for authentication" short:"k" env:"KUBECONFIG"`
-	Context    string `usage:"kubeconfig context for authentication" short:"x" env:"KUBECONTEXT"`
+	Namespace         string `usage:"namespace" short:"n" env:"NAMESPACE" default:"kube-image"`
+	Kubeconfig        string `usage:"kubeconfig for authentication" short:"k" env:"KUBECONFIG"`
+	Context           string `usage:"kubeconfig context for authentication" short:"x" env:"KUBECONTEXT"`
+	Node              string `usage:"builder node to target (default: all builders when listing, pulling, loading, tagging, removing or pruning, otherwise the first)" env:"KIM_NODE"`
+	Transport         string `usage:"how to reach the builder: port-forward through the Kubernetes API server, or direct to its node addresses" env:"KIM_TRANSPORT" default:"port-forward"`
+	SharedCredentials bool   `usage:"also use the registry credentials shared by all users in the kim namespace" env:"KIM_SHARED_CREDENTIALS"`
 }
 
 func (c *Config) Interface() (*Interface, error) {
 	if c == nil {
 		return nil, errors.Errorf("client is not configured, please set client config")
 	}
-	return NewInterface(c.Kubeconfig, c.Context, c.Namespace)
+	k8s, err := NewInterface(c.Kubeconfig, c.Context, c.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	k8s.Node = c.Node
+	k8s.Transport = c.Transport
+	k8s.SharedCredentials = c.SharedCredentials
+	return k8s, nil
 }
 
 type Interface struct {
-	Core      corectlv1.Interface
-	Apps      appsctlv1.Interface
-	RBAC      rbacctlv1.Interface
-	Apply     apply.Apply
+	Core  corectlv1.Interface
+	Apps  appsctlv1.Interface
+	RBAC  rbacctlv1.Interface
+	Apply apply.Apply
+	// Authentication and Authorization review the tokens and requests of clients on behalf of the agent
+	Authentication authenticationv1.AuthenticationV1Interface
+	Authorization  authorizationv1.AuthorizationV1Interface
+	// Dynamic manages resources of other projects, such as the Certificates of cert-manager
+	Dynamic   dynamic.Interface
 	Namespace string
+	Node      string
+	Transport string
+	// UserNamespace is the namespace of the kubeconfig context, where the caller's own registry credentials are kept
+	UserNamespace string
+	// SharedCredentials has the registry credentials of the kim Namespace used along with the caller's own
+	SharedCredentials bool
+
+	config *rest.Config
 }
 
 func NewInterface(kubecfg, kubectx, kubens string) (*Interface, error) {
@@ -72,6 +97,10 @@ func NewInterface(kubecfg, kubectx, kubens string) (*Interface, error) {
 		return nil, err
 	}
 
+	userNamespace := ns
+	if userNamespace == "" {
+		userNamespace = metav1.NamespaceDefault
+	}
 	if kubens != "" {
 		ns = kubens
 	}
@@ -82,7 +111,9 @@ func NewInterface(kubecfg, kubectx, kubens string) (*Interface, error) {
 	}
 
 	c := &Interface{
-		Namespace: ns,
+		Namespace:     ns,
+		UserNamespace: userNamespace,
+		config:        rc,
 	}
 
 	core, err := corectl.NewFactoryFromConfig(rc)
@@ -103,6 +134,21 @@ func NewInterface(kubecfg, kubectx, kubens string) (*Interface, error) {
 	}
 	c.RBAC = rbac.Rbac().V1()
 
+	c.Authentication, err = authenticationv1.NewForConfig(rc)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Authorization, err = authorizationv1.NewForConfig(rc)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Dynamic, err = dynamic.NewForConfig(rc)
+	if err != nil {
+		return nil, err
+	}
+
 	c.Apply, err = apply.NewForConfig(rc)
 	if err != nil {
 		return nil, err
@@ -121,42 +167,84 @@ func NewInterface(kubecfg, kubectx, kubens string) (*Interface, error) {
 	return c, nil
 }
 
-func GetServiceAddress(_ context.Context, k8s *Interface, port string) (string, error) {
-	// TODO handle multiple addresses
+// Endpoint is a builder service port on a particular node, at an address and in a pod.
+type Endpoint struct {
+	Node    string
+	Address string
+	Pod     string
+	Port    int32
+}
+
+// GetServiceEndpoint returns the service port on the targeted builder node, or the first available if no node has been
+// specified.
+func GetServiceEndpoint(ctx context.Context, k8s *Interface, port string) (Endpoint, error) {
+	endpoints, err := GetServiceEndpoints(ctx, k8s, port)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	return endpoints[0], nil
+}
+
+// GetServiceEndpoints returns the addresses of the service port on all builder nodes, ordered by node name, or only
+// that of the targeted node if one has been specified.
+func GetServiceEndpoints(_ context.Context, k8s *Interface, port string) ([]Endpoint, error) {
 	service, err := k8s.Core.Service().Get(k8s.Namespace, "builder", metav1.GetOptions{})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	endpoints, err := k8s.Core.Endpoints().Get(k8s.Namespace, "builder", metav1.GetOptions{})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	var result []Endpoint
 	for _, sub := range endpoints.Subsets {
-		if len(sub.Addresses) > 0 {
-			for _, p := range sub.Ports {
-				if p.Name == port {
-					host := sub.Addresses[0].IP
-					if override, ok := service.Annotations["images.cattle.io/endpoint-override"]; ok {
-						host = override
-					}
-					return net.JoinHostPort(host, strconv.FormatInt(int64(p.Port), 10)), nil
+		for _, p := range sub.Ports {
+			if p.Name != port {
+				continue
+			}
+			for _, addr := range sub.Addresses {
+				node := addr.IP
+				if addr.NodeName != nil {
+					node = *addr.NodeName
 				}
+				var pod string
+				if addr.TargetRef != nil && addr.TargetRef.Kind == "Pod" {
+					pod = addr.TargetRef.Name
+				}
+				result = append(result, Endpoint{
+					Node:    node,
+					Address: net.JoinHostPort(addr.IP, strconv.FormatInt(int64(p.Port), 10)),
+					Pod:     pod,
+					Port:    p.Port,
+				})
 			}
 		}
 	}
-	return "", errors.New("unknown service port")
-}
-
-func GetDockerKeyring(_ context.Context, k8s *Interface) credentialprovider.DockerKeyring {
-	secret, err := k8s.Core.Secret().Get(k8s.Namespace, "kim-docker-config", metav1.GetOptions{})
-	if err != nil {
-		logrus.Debug(err)
-		return credentialprovider.NewDockerKeyring()
+	// the override is a single address and so can only stand in for a single builder node
+	if override, ok := service.Annotations["images.cattle.io/endpoint-override"]; ok {
+		if len(result) == 1 {
+			result[0].Address = net.JoinHostPort(override, strconv.FormatInt(int64(result[0].Port), 10))
+		} else if len(result) > 1 {
+			logrus.Warnf("ignoring endpoint-override %s of service %s/builder with %d builder nodes", override, k8s.Namespace, len(result))
+		}
 	}
-	keyring, err := secrets.MakeDockerKeyring([]corev1.Secret{*secret}, nil)
-	if err != nil {
-		logrus.Debug(err)
-		return credentialprovider.NewDockerKeyring()
+	if k8s.Node != "" {
+		var targeted []Endpoint
+		for _, endpoint := range result {
+			if endpoint.Node == k8s.Node {
+				targeted = append(targeted, endpoint)
+			}
+		}
+		result = targeted
+	}
+	if len(result) == 0 {
+		if k8s.Node != "" {
+			return nil, errors.Errorf("no builder available on node %q", k8s.Node)
+		}
+		return nil, errors.New("unknown service port")
 	}
-	return keyring
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Node < result[j].Node
+	})
+	return result, nil
 }
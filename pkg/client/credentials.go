@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/docker/cli/cli/config"
+	"github.com/docker/distribution/reference"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+	"k8s.io/kubernetes/pkg/credentialprovider"
+	"k8s.io/kubernetes/pkg/credentialprovider/secrets"
+)
+
+// DockerConfigSecret is the name of the secrets holding registry credentials, both the caller's own in their
+// UserNamespace and those shared by all users in the kim Namespace.
+const DockerConfigSecret = "kim-docker-config"
+
+// CredentialNamespaces returns the namespaces to look for registry credentials in, in order of precedence: the
+// caller's own followed, if opted into, by the shared.
+func CredentialNamespaces(k8s *Interface) []string {
+	if k8s.UserNamespace == "" || k8s.UserNamespace == k8s.Namespace {
+		if !k8s.SharedCredentials {
+			return nil
+		}
+		return []string{k8s.Namespace}
+	}
+	if !k8s.SharedCredentials {
+		return []string{k8s.UserNamespace}
+	}
+	return []string{k8s.UserNamespace, k8s.Namespace}
+}
+
+// GetDockerConfigJSON merges the registry credentials of the caller with those that are shared, if opted into, the
+// caller's taking precedence for the same server. Secrets that the caller may not read are skipped.
+func GetDockerConfigJSON(_ context.Context, k8s *Interface) (credentialprovider.DockerConfigJSON, bool) {
+	merged := credentialprovider.DockerConfigJSON{
+		Auths: credentialprovider.DockerConfig{},
+	}
+	found := false
+	namespaces := CredentialNamespaces(k8s)
+	for i := len(namespaces) - 1; i >= 0; i-- {
+		secret, err := k8s.Core.Secret().Get(namespaces[i], DockerConfigSecret, metav1.GetOptions{})
+		switch {
+		case err != nil:
+			logrus.Debugf("skipping %s/%s with error: %v", namespaces[i], DockerConfigSecret, err)
+			continue
+		case secret.Type != corev1.SecretTypeDockerConfigJson:
+			logrus.Warnf("skipping %s/%s with unsupported type: %s", namespaces[i], DockerConfigSecret, secret.Type)
+			continue
+		}
+		dockerConfigJSONBytes, ok := secret.Data[corev1.DockerConfigJsonKey]
+		if !ok {
+			logrus.Warnf("skipping %s/%s with missing value %s", namespaces[i], DockerConfigSecret, corev1.DockerConfigJsonKey)
+			continue
+		}
+		dockerConfigJSON := credentialprovider.DockerConfigJSON{}
+		if err = json.Unmarshal(dockerConfigJSONBytes, &dockerConfigJSON); err != nil {
+			logrus.Warnf("skipping %s/%s with invalid value %s: %v", namespaces[i], DockerConfigSecret, corev1.DockerConfigJsonKey, err)
+			continue
+		}
+		for server, entry := range dockerConfigJSON.Auths {
+			merged.Auths[server] = entry
+		}
+		found = true
+	}
+	return merged, found
+}
+
+// DockerConfig writes the registry credentials of the caller, if any, as config.json to dir and points DOCKER_CONFIG
+// at it so that buildkit session auth providers can find it.
+func DockerConfig(ctx context.Context, k8s *Interface, dir string) error {
+	dockerConfigJSON, ok := GetDockerConfigJSON(ctx, k8s)
+	if !ok {
+		return nil
+	}
+	dockerConfigJSONBytes, err := json.Marshal(&dockerConfigJSON)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal docker config")
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "config.json"), dockerConfigJSONBytes, 0600); err != nil {
+		return errors.Wrap(err, "failed to write docker config")
+	}
+	if err := os.Setenv("DOCKER_CONFIG", dir); err != nil {
+		return errors.Wrap(err, "failed to setup docker config")
+	}
+	return nil
+}
+
+// GetDockerKeyring returns a keyring of the registry credentials of the caller.
+func GetDockerKeyring(ctx context.Context, k8s *Interface) credentialprovider.DockerKeyring {
+	dockerConfigJSON, ok := GetDockerConfigJSON(ctx, k8s)
+	if !ok {
+		return credentialprovider.NewDockerKeyring()
+	}
+	dockerConfigJSONBytes, err := json.Marshal(&dockerConfigJSON)
+	if err != nil {
+		logrus.Debug(err)
+		return credentialprovider.NewDockerKeyring()
+	}
+	keyring, err := secrets.MakeDockerKeyring([]corev1.Secret{{
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: dockerConfigJSONBytes,
+		},
+	}}, nil)
+	if err != nil {
+		logrus.Debug(err)
+		return credentialprovider.NewDockerKeyring()
+	}
+	return keyring
+}
+
+// GetAuthConfig returns the credentials to send along with a pull or push of the image, or nil for none. Credentials
+// kept in the cluster are preferred, falling back to the local docker config.json, including its credsStore and
+// credHelpers, and then to the registered credential helpers. Local credentials are never written to the cluster.
+func GetAuthConfig(ctx context.Context, k8s *Interface, image string) *criv1.AuthConfig {
+	if auth, ok := GetDockerKeyring(ctx, k8s).Lookup(image); ok {
+		return authConfig(auth[0])
+	}
+	if auth, ok := localAuthConfig(image); ok {
+		return auth
+	}
+	if auth, ok := credentialprovider.NewDockerKeyring().Lookup(image); ok {
+		return authConfig(auth[0])
+	}
+	return nil
+}
+
+func authConfig(auth credentialprovider.AuthConfig) *criv1.AuthConfig {
+	return &criv1.AuthConfig{
+		Username:      auth.Username,
+		Password:      auth.Password,
+		Auth:          auth.Auth,
+		ServerAddress: auth.ServerAddress,
+		IdentityToken: auth.IdentityToken,
+		RegistryToken: auth.RegistryToken,
+	}
+}
+
+// localAuthConfig looks up the credentials for the registry of the image in the local docker config.
+func localAuthConfig(image string) (*criv1.AuthConfig, bool) {
+	name, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return nil, false
+	}
+	server := reference.Domain(name)
+	if server == "docker.io" {
+		server = "https://index.docker.io/v1/"
+	}
+	auth, err := config.LoadDefaultConfigFile(ioutil.Discard).GetAuthConfig(server)
+	if err != nil {
+		logrus.Debugf("skipping local docker config for %s with error: %v", server, err)
+		return nil, false
+	}
+	if auth.Username == "" && auth.Password == "" && auth.IdentityToken == "" && auth.RegistryToken == "" {
+		return nil, false
+	}
+	if auth.ServerAddress == "" {
+		auth.ServerAddress = server
+	}
+	return &criv1.AuthConfig{
+		Username:      auth.Username,
+		Password:      auth.Password,
+		ServerAddress: auth.ServerAddress,
+		IdentityToken: auth.IdentityToken,
+		RegistryToken: auth.RegistryToken,
+	}, true
+}
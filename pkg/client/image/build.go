@@ -3,11 +3,13 @@ package image
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/containerd/console"
+	"github.com/containerd/containerd/platforms"
 	"github.com/docker/distribution/reference"
 	buildkit "github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/cmd/buildctl/build"
@@ -15,6 +17,8 @@ import (
 	"github.com/moby/buildkit/session/auth/authprovider"
 	"github.com/moby/buildkit/session/sshforward/sshprovider"
 	"github.com/moby/buildkit/util/progress/progressui"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
 	"github.com/rancher/kim/pkg/client"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
@@ -23,11 +27,13 @@ import (
 type Build struct {
 	AddHost   []string `usage:"Add a custom host-to-IP mapping (host:ip)"`
 	BuildArg  []string `usage:"Set build-time variables"`
-	CacheFrom []string `usage:"Images to consider as cache sources"`
+	CacheFrom []string `usage:"External cache sources (e.g. user/app:cache, type=registry,ref=user/app:cache, type=local,src=path/to/dir)" split:"false"`
+	CacheTo   []string `usage:"Cache export destinations (e.g. type=registry,ref=user/app:cache,mode=max, type=inline, type=local,dest=path/to/dir)" split:"false"`
 	File      string   `usage:"Name of the Dockerfile (Default is 'PATH/Dockerfile')" short:"f"`
 	Label     []string `usage:"Set metadata for an image"`
 	NoCache   bool     `usage:"Do not use cache when building the image"`
 	Output    []string `usage:"BuildKit-style output directives (e.g. type=local,dest=path/to/output-dir)" short:"o" split:"false"`
+	Platform  []string `usage:"Set target platform(s) for build (e.g. linux/amd64,linux/arm64)"`
 	Progress  string   `usage:"Set type of progress output (auto, plain, tty). Use plain to show container output" default:"auto"`
 	Quiet     bool     `usage:"Suppress the build output and print image ID on success" short:"q"`
 	Tag       []string `usage:"Name and optionally a tag in the 'name:tag' format" short:"t"`
@@ -39,11 +45,35 @@ type Build struct {
 }
 
 func (s *Build) Do(ctx context.Context, k8s *client.Interface, path string) error {
-	return client.Control(ctx, k8s, func(ctx context.Context, bkc *buildkit.Client) error {
+	tmp, err := ioutil.TempDir("", "kim-build-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temp directory")
+	}
+	defer os.RemoveAll(tmp)
+	if err = client.DockerConfig(ctx, k8s, tmp); err != nil {
+		return err
+	}
+	cacheImports, err := s.cacheImports()
+	if err != nil {
+		return err
+	}
+	cacheExports, err := s.cacheExports()
+	if err != nil {
+		return err
+	}
+	if s.Squash && len(s.Tag) == 0 {
+		return errors.New("--squash requires --tag")
+	}
+	frontendAttrs, err := s.frontendAttrs()
+	if err != nil {
+		return err
+	}
+	return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
 		options := buildkit.SolveOpt{
 			Frontend:      "dockerfile.v0",
-			FrontendAttrs: s.frontendAttrs(),
-			CacheImports:  s.cacheImports(),
+			FrontendAttrs: frontendAttrs,
+			CacheExports:  cacheExports,
+			CacheImports:  cacheImports,
 			LocalDirs:     s.localDirs(path),
 			Session:       []session.Attachable{authprovider.NewDockerAuthProvider(os.Stderr)},
 		}
@@ -61,6 +91,14 @@ func (s *Build) Do(ctx context.Context, k8s *client.Interface, path string) erro
 			}
 			options.Exports = append(options.Exports, exports...)
 		}
+		if s.Squash {
+			// the agent squashes after the solve, by which time outputs have already been pushed
+			for _, export := range options.Exports {
+				if export.Type == buildkit.ExporterImage && export.Attrs["push"] == "true" || export.Type == "registry" {
+					return errors.New("--squash cannot be combined with pushing outputs, `kim push` the squashed image instead")
+				}
+			}
+		}
 		if len(s.Secret) > 0 {
 			attachable, err := build.ParseSecret(s.Secret)
 			if err != nil {
@@ -83,7 +121,7 @@ func (s *Build) Do(ctx context.Context, k8s *client.Interface, path string) erro
 			s.Progress = "none"
 		}
 		eg := errgroup.Group{}
-		res, err := bkc.Solve(ctx, nil, options, s.progress(&eg))
+		res, err := solve(ctx, imagesClient, options, s.Squash, s.progress(&eg))
 		if err != nil {
 			return err
 		}
@@ -94,13 +132,16 @@ func (s *Build) Do(ctx context.Context, k8s *client.Interface, path string) erro
 		if s.Quiet && res.ExporterResponse != nil {
 			if id := res.ExporterResponse["containerimage.config.digest"]; id != "" {
 				fmt.Fprintln(os.Stdout, id)
+			} else if dgst := res.ExporterResponse["containerimage.digest"]; dgst != "" {
+				// multi-platform builds produce an index, which has no single config
+				fmt.Fprintln(os.Stdout, dgst)
 			}
 		}
 		return nil
 	})
 }
 
-func (s *Build) frontendAttrs() map[string]string {
+func (s *Build) frontendAttrs() (map[string]string, error) {
 	// --target
 	m := map[string]string{
 		"target": s.Target,
@@ -138,11 +179,19 @@ func (s *Build) frontendAttrs() map[string]string {
 	if s.Pull {
 		m["image-resolve-mode"] = "pull"
 	}
-	// --squash
-	if s.Squash {
-		logrus.Warn("Squash not currently supported by the buildkit backend")
+	// --platform
+	if len(s.Platform) > 0 {
+		var specs []string
+		for _, p := range s.Platform {
+			platform, err := platforms.Parse(p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse platform %q", p)
+			}
+			specs = append(specs, platforms.Format(platform))
+		}
+		m["platform"] = strings.Join(specs, ",")
 	}
-	return m
+	return m, nil
 }
 
 func (s *Build) localDirs(path string) map[string]string {
@@ -157,23 +206,36 @@ func (s *Build) localDirs(path string) map[string]string {
 	return m
 }
 
-func (s *Build) cacheImports() (result []buildkit.CacheOptionsEntry) {
+// cacheImports parses --cache-from, plain image references being shorthand for type=registry,ref=<image>
+func (s *Build) cacheImports() ([]buildkit.CacheOptionsEntry, error) {
+	return parseCacheEntries(s.CacheFrom, build.ParseImportCache)
+}
+
+// cacheExports parses --cache-to, plain image references being shorthand for type=registry,ref=<image>
+func (s *Build) cacheExports() ([]buildkit.CacheOptionsEntry, error) {
+	return parseCacheEntries(s.CacheTo, func(entries []string) ([]buildkit.CacheOptionsEntry, error) {
+		return build.ParseExportCache(entries, nil)
+	})
+}
+
+func parseCacheEntries(entries []string, parse func([]string) ([]buildkit.CacheOptionsEntry, error)) ([]buildkit.CacheOptionsEntry, error) {
+	var normalized []string
 	exists := map[string]bool{}
-	for _, s := range s.CacheFrom {
-		if exists[s] {
+	for _, entry := range entries {
+		if !strings.Contains(entry, "type=") {
+			entry = fmt.Sprintf("type=registry,ref=%s", entry)
+		}
+		if exists[entry] {
 			continue
 		}
-		exists[s] = true
-
-		result = append(result, buildkit.CacheOptionsEntry{
-			Type: "registry",
-			Attrs: map[string]string{
-				"ref": s,
-			},
-		})
+		exists[entry] = true
+		normalized = append(normalized, entry)
 	}
-
-	return
+	result, err := parse(normalized)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse cache options")
+	}
+	return result, nil
 }
 
 func (s *Build) progress(group *errgroup.Group) chan *buildkit.SolveStatus {
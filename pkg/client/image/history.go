@@ -0,0 +1,121 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/containerd/containerd/platforms"
+	"github.com/docker/go-units"
+	"github.com/pkg/errors"
+	"github.com/rancher/kim/pkg/apis/services/images"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+)
+
+type History struct {
+	Format   string `usage:"Pretty-print history using a Go template"`
+	NoTrunc  bool   `usage:"Don't truncate output"`
+	Platform string `usage:"Show history for a specific platform (default is that of the builder)"`
+	Quiet    bool   `usage:"Only show layer digests" short:"q"`
+}
+
+// HistoryEntry is what is made available to --format templates
+type HistoryEntry struct {
+	ID           string
+	Layer        string
+	CreatedAt    string
+	CreatedSince string
+	CreatedBy    string
+	Size         string
+	Comment      string
+	EmptyLayer   bool
+}
+
+func (s *History) Do(ctx context.Context, k8s *client.Interface, name string) error {
+	req := &imagesv1.ImageHistoryRequest{}
+	if s.Platform != "" {
+		platform, err := platforms.Parse(s.Platform)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse platform")
+		}
+		req.Platform = platforms.Format(platform)
+	}
+	var tmpl *template.Template
+	if s.Format != "" {
+		t, err := template.New("history").Funcs(templateFuncs).Parse(s.Format)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse format")
+		}
+		tmpl = t
+	}
+	return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+		ref, err := refSpec(ctx, imagesClient, name)
+		if err != nil {
+			return err
+		}
+		if ref == nil {
+			return errors.Errorf("image %q: not found", name)
+		}
+		req.Image = ref
+		res, err := imagesClient.History(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		display := newTableDisplay(20, 1, 3, ' ', 0)
+		if !s.Quiet && tmpl == nil {
+			display.AddRow([]string{columnImageID, columnLayer, columnCreated, columnCreatedBy, columnSize, columnComment})
+		}
+		for i, h := range res.History {
+			entry := s.entry(res.Id, i, h)
+			switch {
+			case s.Quiet:
+				if !h.EmptyLayer {
+					fmt.Println(entry.Layer)
+				}
+			case tmpl != nil:
+				if err := tmpl.Execute(os.Stdout, entry); err != nil {
+					return err
+				}
+				fmt.Println()
+			default:
+				display.AddRow([]string{entry.ID, entry.Layer, entry.CreatedSince, entry.CreatedBy, entry.Size, entry.Comment})
+			}
+		}
+		return display.Flush()
+	})
+}
+
+func (s *History) entry(id string, i int, h imagesv1.ImageHistory) HistoryEntry {
+	// like docker, only the most recent entry is attributed to the image
+	if i > 0 {
+		id = "<missing>"
+	}
+	entry := HistoryEntry{
+		ID:         id,
+		Layer:      h.Layer,
+		CreatedBy:  strings.Join(strings.Fields(h.CreatedBy), " "),
+		Size:       units.HumanSizeWithPrecision(float64(h.Size_), 3),
+		Comment:    h.Comment,
+		EmptyLayer: h.EmptyLayer,
+	}
+	if h.EmptyLayer || h.Layer == "" {
+		entry.Layer = "<empty>"
+	}
+	if !h.Created.IsZero() {
+		entry.CreatedAt = h.Created.Format(time.RFC3339)
+		entry.CreatedSince = units.HumanDuration(time.Since(h.Created)) + " ago"
+	}
+	if !s.NoTrunc {
+		entry.ID = images.TruncateID(entry.ID, "sha256:", 13)
+		entry.Layer = images.TruncateID(entry.Layer, "sha256:", 13)
+		if len(entry.CreatedBy) > 45 {
+			entry.CreatedBy = entry.CreatedBy[:44] + "…"
+		}
+	}
+	return entry
+}
@@ -0,0 +1,170 @@
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/containerd/containerd/platforms"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+)
+
+type Inspect struct {
+	Format   string `usage:"Format the output using the given Go template" short:"f"`
+	Platform string `usage:"Inspect the manifest for a specific platform (default is that of the builder)"`
+}
+
+// ImageInspect is modeled after the output of `docker image inspect`
+type ImageInspect struct {
+	Id           string
+	RepoTags     []string
+	RepoDigests  []string
+	Name         string
+	MediaType    string
+	Digest       string
+	Created      *time.Time `json:",omitempty"`
+	Author       string     `json:",omitempty"`
+	Architecture string
+	Os           string
+	Variant      string `json:",omitempty"`
+	Size         uint64
+	Platforms    []string
+	Config       ocispec.ImageConfig
+	RootFS       ocispec.RootFS
+	Layers       []ImageInspectLayer
+	History      []ocispec.History
+}
+
+type ImageInspectLayer struct {
+	Digest    digest.Digest
+	MediaType string
+	Size      int64
+}
+
+func (s *Inspect) Do(ctx context.Context, k8s *client.Interface, names []string) error {
+	req := &imagesv1.ImageInspectRequest{}
+	if s.Platform != "" {
+		platform, err := platforms.Parse(s.Platform)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse platform")
+		}
+		req.Platform = platforms.Format(platform)
+	}
+	var tmpl *template.Template
+	if s.Format != "" {
+		t, err := template.New("inspect").Funcs(templateFuncs).Parse(s.Format)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse format")
+		}
+		tmpl = t
+	}
+	return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+		var results []*ImageInspect
+		for _, name := range names {
+			ref, err := refSpec(ctx, imagesClient, name)
+			if err != nil {
+				return err
+			}
+			if ref == nil {
+				return errors.Errorf("image %q: not found", name)
+			}
+			req.Image = ref
+			res, err := imagesClient.Inspect(ctx, req)
+			if err != nil {
+				return errors.Wrapf(err, "image %q", name)
+			}
+			inspect, err := newImageInspect(res)
+			if err != nil {
+				return errors.Wrapf(err, "image %q", name)
+			}
+			results = append(results, inspect)
+		}
+		if tmpl != nil {
+			for _, result := range results {
+				if err := tmpl.Execute(os.Stdout, result); err != nil {
+					return err
+				}
+				fmt.Fprintln(os.Stdout)
+			}
+			return nil
+		}
+		out, err := json.MarshalIndent(results, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stdout, string(out))
+		return nil
+	})
+}
+
+func newImageInspect(res *imagesv1.ImageInspectResponse) (*ImageInspect, error) {
+	var (
+		manifest ocispec.Manifest
+		config   ocispec.Image
+	)
+	if err := json.Unmarshal(res.Manifest, &manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to parse manifest")
+	}
+	if err := json.Unmarshal(res.Config, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse config")
+	}
+	inspect := &ImageInspect{
+		Name:         res.Name,
+		MediaType:    res.MediaType,
+		Digest:       res.Digest,
+		Created:      config.Created,
+		Author:       config.Author,
+		Architecture: config.Architecture,
+		Os:           config.OS,
+		Platforms:    res.Platforms,
+		Config:       config.Config,
+		RootFS:       config.RootFS,
+		History:      config.History,
+	}
+	if res.Image != nil {
+		inspect.Id = res.Image.Id
+		inspect.RepoTags = res.Image.RepoTags
+		inspect.RepoDigests = res.Image.RepoDigests
+		inspect.Size = res.Image.Size_
+	}
+	if inspect.Id == "" {
+		inspect.Id = manifest.Config.Digest.String()
+	}
+	if len(res.Index) > 0 {
+		var index ocispec.Index
+		if err := json.Unmarshal(res.Index, &index); err != nil {
+			return nil, errors.Wrap(err, "failed to parse index")
+		}
+		for _, desc := range index.Manifests {
+			if desc.Platform != nil && desc.Platform.Architecture == config.Architecture && desc.Platform.OS == config.OS {
+				inspect.Variant = desc.Platform.Variant
+			}
+		}
+	}
+	for _, layer := range manifest.Layers {
+		inspect.Layers = append(inspect.Layers, ImageInspectLayer{
+			Digest:    layer.Digest,
+			MediaType: layer.MediaType,
+			Size:      layer.Size,
+		})
+	}
+	return inspect, nil
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) string {
+		out, _ := json.Marshal(v)
+		return string(out)
+	},
+	"join":  strings.Join,
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
@@ -2,12 +2,16 @@ package image
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 	"text/tabwriter"
+	"text/template"
+	"time"
 
 	"github.com/docker/go-units"
+	"github.com/pkg/errors"
 	"github.com/rancher/kim/pkg/apis/services/images"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
 	"github.com/rancher/kim/pkg/client"
@@ -15,41 +19,85 @@ import (
 )
 
 type List struct {
-	All     bool `usage:"Show all images (default hides tag-less images)" short:"a"`
-	Digests bool `usage:"Show digests"`
-	//Filter  string `usage:"Filter output based on conditions provided" short:"f"`
-	//Format  string `usage:"Pretty-print images using a Go template"`
-	NoTrunc bool `usage:"Don't truncate output"`
-	Quiet   bool `usage:"Only show image IDs" short:"q"`
+	All     bool     `usage:"Show all images (default hides tag-less images)" short:"a"`
+	Digests bool     `usage:"Show digests"`
+	Filter  []string `usage:"Filter output based on conditions provided (dangling, reference, label, before, since, until)" short:"f"`
+	Format  string   `usage:"Pretty-print images using a Go template, or 'json'"`
+	NoTrunc bool     `usage:"Don't truncate output"`
+	Quiet   bool     `usage:"Only show image IDs" short:"q"`
+}
+
+// ImageSummary is what is made available to --format templates, and what is written per line with --format=json
+type ImageSummary struct {
+	Node         string `json:",omitempty"`
+	ID           string
+	Repository   string
+	Tag          string
+	Digest       string
+	Platforms    []string
+	Size         string
+	CreatedAt    string
+	CreatedSince string
+	Labels       map[string]string `json:",omitempty"`
 }
 
 func (s *List) Do(ctx context.Context, k8s *client.Interface, names []string) error {
-	return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
-		req := &imagesv1.ImageListRequest{}
-		// TODO filtering not working as expected
-		if len(names) > 0 {
-			req.Filter = &criv1.ImageFilter{
-				Image: &criv1.ImageSpec{
-					Image: names[0],
-				},
-			}
+	type nodeImages struct {
+		node     string
+		images   []*criv1.Image
+		metadata map[string]*imagesv1.ImageMetadata
+	}
+	var tmpl *template.Template
+	if s.Format != "" && s.Format != "json" {
+		t, err := template.New("images").Funcs(templateFuncs).Parse(s.Format)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse format")
+		}
+		tmpl = t
+	}
+	var results []nodeImages
+	err := client.ImagesEach(ctx, k8s, func(ctx context.Context, node string, imagesClient imagesv1.ImagesClient) error {
+		req := &imagesv1.ImageListRequest{
+			Filters:    s.Filter,
+			References: names,
 		}
 		res, err := imagesClient.List(ctx, req)
 		if err != nil {
 			return err
 		}
 		images.Sort(res.Images)
+		results = append(results, nodeImages{node: node, images: res.Images, metadata: res.Metadata})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		// output in table format by default.
-		display := newTableDisplay(20, 1, 3, ' ', 0)
-		if !s.Quiet {
-			if s.Digests {
-				display.AddRow([]string{columnImage, columnTag, columnDigest, columnImageID, columnSize})
-			} else {
-				display.AddRow([]string{columnImage, columnTag, columnImageID, columnSize})
-			}
+	// explicitly asking for dangling images implies wanting to see them
+	all := s.All
+	for _, filter := range s.Filter {
+		all = all || strings.HasPrefix(filter, "dangling=")
+	}
+
+	// only show the node column when listing from more than one builder
+	showNode := len(results) > 1
+
+	// output in table format by default.
+	display := newTableDisplay(20, 1, 3, ' ', 0)
+	if !s.Quiet && s.Format == "" {
+		var header []string
+		if showNode {
+			header = append(header, columnNode)
 		}
-		for _, image := range res.Images {
+		if s.Digests {
+			header = append(header, columnImage, columnTag, columnDigest, columnImageID, columnPlatforms, columnSize)
+		} else {
+			header = append(header, columnImage, columnTag, columnImageID, columnPlatforms, columnSize)
+		}
+		display.AddRow(header)
+	}
+	for _, result := range results {
+		for _, image := range result.images {
 			if s.Quiet {
 				fmt.Printf("%s\n", image.Id)
 				continue
@@ -57,51 +105,88 @@ func (s *List) Do(ctx context.Context, k8s *client.Interface, names []string) er
 			imageName, repoDigest := images.NormalizeRepoDigest(image.RepoDigests)
 			repoTagPairs := images.NormalizeRepoTagPair(image.RepoTags, imageName)
 			size := units.HumanSizeWithPrecision(float64(image.GetSize_()), 3)
+			meta := result.metadata[image.Id]
+			var platforms []string
+			if meta != nil {
+				platforms = meta.Platforms
+			}
 			id := image.Id
 			if !s.NoTrunc {
 				id = images.TruncateID(id, "sha256:", 13)
 				repoDigest = images.TruncateID(repoDigest, "sha256:", 13)
 			}
 			for _, repoTagPair := range repoTagPairs {
-				if !s.All && repoDigest == "<none>" {
+				if !all && repoDigest == "<none>" {
+					continue
+				}
+				if s.Format != "" {
+					summary := ImageSummary{
+						ID:         id,
+						Repository: repoTagPair[0],
+						Tag:        repoTagPair[1],
+						Digest:     repoDigest,
+						Platforms:  platforms,
+						Size:       size,
+					}
+					if showNode {
+						summary.Node = result.node
+					}
+					if meta != nil {
+						summary.Labels = meta.Labels
+						if !meta.Created.IsZero() {
+							summary.CreatedAt = meta.Created.Format(time.RFC3339)
+							summary.CreatedSince = units.HumanDuration(time.Since(meta.Created)) + " ago"
+						}
+					}
+					if err := s.print(tmpl, summary); err != nil {
+						return err
+					}
 					continue
 				}
+				var row []string
+				if showNode {
+					row = append(row, result.node)
+				}
 				if s.Digests {
-					display.AddRow([]string{repoTagPair[0], repoTagPair[1], repoDigest, id, size})
+					row = append(row, repoTagPair[0], repoTagPair[1], repoDigest, id, strings.Join(platforms, ","), size)
 				} else {
-					display.AddRow([]string{repoTagPair[0], repoTagPair[1], id, size})
+					row = append(row, repoTagPair[0], repoTagPair[1], id, strings.Join(platforms, ","), size)
 				}
+				display.AddRow(row)
 			}
-			continue
-			fmt.Printf("ID: %s\n", image.Id)
-			for _, tag := range image.RepoTags {
-				fmt.Printf("RepoTags: %s\n", tag)
-			}
-			for _, digest := range image.RepoDigests {
-				fmt.Printf("RepoDigests: %s\n", digest)
-			}
-			if image.Size_ != 0 {
-				fmt.Printf("Size: %d\n", image.Size_)
-			}
-			if image.Uid != nil {
-				fmt.Printf("Uid: %v\n", image.Uid)
-			}
-			if image.Username != "" {
-				fmt.Printf("Username: %v\n", image.Username)
-			}
-			fmt.Printf("\n")
 		}
-		display.Flush()
+	}
+	return display.Flush()
+}
+
+func (s *List) print(tmpl *template.Template, summary ImageSummary) error {
+	if tmpl == nil {
+		out, err := json.Marshal(summary)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
 		return nil
-	})
+	}
+	if err := tmpl.Execute(os.Stdout, summary); err != nil {
+		return err
+	}
+	fmt.Println()
+	return nil
 }
 
 const (
-	columnImage   = "IMAGE"
-	columnImageID = "IMAGE ID"
-	columnSize    = "SIZE"
-	columnTag     = "TAG"
-	columnDigest  = "DIGEST"
+	columnImage     = "IMAGE"
+	columnImageID   = "IMAGE ID"
+	columnSize      = "SIZE"
+	columnTag       = "TAG"
+	columnDigest    = "DIGEST"
+	columnNode      = "NODE"
+	columnPlatforms = "PLATFORMS"
+	columnLayer     = "LAYER"
+	columnCreated   = "CREATED"
+	columnCreatedBy = "CREATED BY"
+	columnComment   = "COMMENT"
 )
 
 // display use to output something on screen with table format.
@@ -0,0 +1,89 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/progress"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+)
+
+type Load struct {
+	Input string `usage:"Read from tar archive file, instead of STDIN" short:"i"`
+	Quiet bool   `usage:"Suppress the load output" short:"q"`
+}
+
+func (s *Load) Do(ctx context.Context, k8s *client.Interface) error {
+	// when reading from stdin there is no going back so only the first (or targeted) builder can be loaded
+	if s.Input == "" {
+		return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+			return s.load(ctx, imagesClient, os.Stdin, 0)
+		})
+	}
+	return client.ImagesEach(ctx, k8s, func(ctx context.Context, node string, imagesClient imagesv1.ImagesClient) error {
+		f, err := os.Open(s.Input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		fi, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		logrus.Infof("Loading %s to %s", s.Input, node)
+		return s.load(ctx, imagesClient, f, fi.Size())
+	})
+}
+
+func (s *Load) load(ctx context.Context, imagesClient imagesv1.ImagesClient, in io.Reader, size int64) error {
+	stream, err := imagesClient.Import(ctx)
+	if err != nil {
+		return err
+	}
+	xfer := newTransfer(s.Input, size)
+	eg, ctx := errgroup.WithContext(ctx)
+	done, cancel := context.WithCancel(ctx)
+	if !s.Quiet && s.Input != "" {
+		ch := make(chan []imagesv1.ImageStatus)
+		eg.Go(func() error {
+			return progress.Display(ch, os.Stdout)
+		})
+		go xfer.report(done, ch, "loading")
+	}
+	var res *imagesv1.ImageImportResponse
+	eg.Go(func() error {
+		defer cancel()
+		buf := make([]byte, 1<<20)
+		for {
+			n, err := in.Read(buf)
+			if n > 0 {
+				if err := stream.Send(&imagesv1.ImageImportRequest{Data: buf[:n]}); err != nil {
+					return err
+				}
+				xfer.Add(n)
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+		res, err = stream.CloseAndRecv()
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	if !s.Quiet {
+		for _, image := range res.Images {
+			fmt.Printf("Loaded image: %s\n", image)
+		}
+	}
+	return nil
+}
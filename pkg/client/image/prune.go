@@ -0,0 +1,46 @@
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/go-units"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+)
+
+type Prune struct {
+	All    bool     `usage:"Remove all images not used by a container, not just dangling ones" short:"a"`
+	Filter []string `usage:"Provide filter values (until, label)" short:"f"`
+	DryRun bool     `usage:"Only report what would be removed"`
+}
+
+func (s *Prune) Do(ctx context.Context, k8s *client.Interface) error {
+	action, total := "Deleted", "Total reclaimed space"
+	if s.DryRun {
+		action, total = "Would delete", "Total reclaimable space"
+	}
+	var reclaimed int64
+	err := client.ImagesEach(ctx, k8s, func(ctx context.Context, node string, imagesClient imagesv1.ImagesClient) error {
+		res, err := imagesClient.Prune(ctx, &imagesv1.ImagePruneRequest{
+			All:     s.All,
+			Filters: s.Filter,
+			DryRun:  s.DryRun,
+		})
+		if err != nil {
+			return err
+		}
+		for _, image := range res.Images {
+			for _, ref := range image.Refs {
+				fmt.Printf("%s: %s (%s/%s)\n", action, ref, node, image.Namespace)
+			}
+		}
+		reclaimed += res.SpaceReclaimed
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s: %s\n", total, units.HumanSize(float64(reclaimed)))
+	return nil
+}
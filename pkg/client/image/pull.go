@@ -7,6 +7,7 @@ import (
 
 	"github.com/containerd/containerd/platforms"
 	"github.com/docker/distribution/reference"
+	"github.com/moby/buildkit/identity"
 	"github.com/pkg/errors"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
 	"github.com/rancher/kim/pkg/client"
@@ -27,7 +28,9 @@ func (s *Pull) Do(ctx context.Context, k8s *client.Interface, image string) erro
 		return errors.Wrap(err, "Failed to parse image")
 	}
 	image = reference.TagNameOnly(named).String()
-	return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+	return client.ImagesEach(ctx, k8s, func(ctx context.Context, node string, imagesClient imagesv1.ImagesClient) error {
+		logrus.Infof("Pulling %s to %s", image, node)
+		requestID := identity.NewID()
 		ch := make(chan []imagesv1.ImageStatus)
 		eg, ctx := errgroup.WithContext(ctx)
 		// render output from the channel
@@ -37,7 +40,7 @@ func (s *Pull) Do(ctx context.Context, k8s *client.Interface, image string) erro
 		// render progress to the channel
 		eg.Go(func() error {
 			defer close(ch)
-			ppc, err := imagesClient.PullProgress(ctx, &imagesv1.ImageProgressRequest{Image: image})
+			ppc, err := imagesClient.PullProgress(ctx, &imagesv1.ImageProgressRequest{Image: image, RequestId: requestID})
 			if err != nil {
 				return err
 			}
@@ -51,7 +54,6 @@ func (s *Pull) Do(ctx context.Context, k8s *client.Interface, image string) erro
 				}
 				ch <- info.Status
 			}
-			return nil
 		})
 		// initiate the pull
 		eg.Go(func() error {
@@ -60,6 +62,7 @@ func (s *Pull) Do(ctx context.Context, k8s *client.Interface, image string) erro
 					Image:       image,
 					Annotations: map[string]string{},
 				},
+				RequestId: requestID,
 			}
 			if s.Platform != "" {
 				platform, err := platforms.Parse(s.Platform)
@@ -73,17 +76,7 @@ func (s *Pull) Do(ctx context.Context, k8s *client.Interface, image string) erro
 			if s.Cri {
 				req.Image.Annotations["images.cattle.io/pull-backend"] = "cri"
 			}
-			keyring := client.GetDockerKeyring(ctx, k8s)
-			if auth, ok := keyring.Lookup(image); ok {
-				req.Auth = &criv1.AuthConfig{
-					Username:      auth[0].Username,
-					Password:      auth[0].Password,
-					Auth:          auth[0].Auth,
-					ServerAddress: auth[0].ServerAddress,
-					IdentityToken: auth[0].IdentityToken,
-					RegistryToken: auth[0].RegistryToken,
-				}
-			}
+			req.Auth = client.GetAuthConfig(ctx, k8s, image)
 			res, err := imagesClient.Pull(ctx, req)
 			logrus.Debugf("image-pull: %v", res)
 			return err
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/docker/distribution/reference"
+	"github.com/moby/buildkit/identity"
 	"github.com/pkg/errors"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
 	"github.com/rancher/kim/pkg/client"
@@ -25,6 +26,7 @@ func (s *Push) Do(ctx context.Context, k8s *client.Interface, image string) erro
 	}
 	image = reference.TagNameOnly(named).String()
 	return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+		requestID := identity.NewID()
 		ch := make(chan []imagesv1.ImageStatus)
 		eg, ctx := errgroup.WithContext(ctx)
 		// render output from the channel
@@ -34,7 +36,7 @@ func (s *Push) Do(ctx context.Context, k8s *client.Interface, image string) erro
 		// render progress to the channel
 		eg.Go(func() error {
 			defer close(ch)
-			ppc, err := imagesClient.PushProgress(ctx, &imagesv1.ImageProgressRequest{Image: image})
+			ppc, err := imagesClient.PushProgress(ctx, &imagesv1.ImageProgressRequest{Image: image, RequestId: requestID})
 			if err != nil {
 				return err
 			}
@@ -48,7 +50,6 @@ func (s *Push) Do(ctx context.Context, k8s *client.Interface, image string) erro
 				}
 				ch <- info.Status
 			}
-			return nil
 		})
 		// initiate the push
 		eg.Go(func() error {
@@ -56,18 +57,9 @@ func (s *Push) Do(ctx context.Context, k8s *client.Interface, image string) erro
 				Image: &criv1.ImageSpec{
 					Image: image,
 				},
+				RequestId: requestID,
 			}
-			keyring := client.GetDockerKeyring(ctx, k8s)
-			if auth, ok := keyring.Lookup(image); ok {
-				req.Auth = &criv1.AuthConfig{
-					Username:      auth[0].Username,
-					Password:      auth[0].Password,
-					Auth:          auth[0].Auth,
-					ServerAddress: auth[0].ServerAddress,
-					IdentityToken: auth[0].IdentityToken,
-					RegistryToken: auth[0].RegistryToken,
-				}
-			}
+			req.Auth = client.GetAuthConfig(ctx, k8s, image)
 			res, err := imagesClient.Push(ctx, req)
 			logrus.Debugf("image-push: %v", res)
 			return err
@@ -2,27 +2,88 @@ package image
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/containerd/containerd/errdefs"
 	"github.com/pkg/errors"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
 	"github.com/rancher/kim/pkg/client"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 )
 
 type Remove struct {
+	Force bool `usage:"Force removal of images referenced by several repositories, and untag those in use by a container" short:"f"`
 }
 
-func (s *Remove) Do(ctx context.Context, k8s *client.Interface, image string) error {
-	return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
-		ref, err := refSpec(ctx, imagesClient, image)
+func (s *Remove) Do(ctx context.Context, k8s *client.Interface, names []string) error {
+	removed := map[string]bool{}
+	failed := map[string]error{}
+	err := client.ImagesEach(ctx, k8s, func(ctx context.Context, node string, imagesClient imagesv1.ImagesClient) error {
+		req := &imagesv1.ImageRemoveRequest{Force: s.Force}
+		// the names requested by the image of their ref, which the results are matched on
+		reqNames := map[string][]string{}
+		for _, name := range names {
+			ref, err := refSpec(ctx, imagesClient, name)
+			if err != nil {
+				return err
+			}
+			if ref == nil {
+				logrus.Debugf("image-remove: %q not found on %s", name, node)
+				continue
+			}
+			req.Images = append(req.Images, ref)
+			reqNames[ref.Image] = append(reqNames[ref.Image], name)
+		}
+		if len(req.Images) == 0 {
+			return nil
+		}
+		res, err := imagesClient.Remove(ctx, req)
 		if err != nil {
 			return err
 		}
-		if ref == nil {
-			return errors.Errorf("image %q: not found", image)
+		if len(res.Results) != len(req.Images) {
+			return errors.Errorf("node %s returned %d results for %d images", node, len(res.Results), len(req.Images))
 		}
-		res, err := imagesClient.Remove(ctx, &imagesv1.ImageRemoveRequest{Image: ref})
-		logrus.Debugf("%#v", res)
-		return err
+		for _, result := range res.Results {
+			pending := reqNames[result.Image.GetImage()]
+			if len(pending) == 0 {
+				return errors.Errorf("node %s returned a result for %q, which was not requested", node, result.Image.GetImage())
+			}
+			name := pending[0]
+			reqNames[result.Image.GetImage()] = pending[1:]
+			if result.Code != int32(codes.OK) {
+				err := errdefs.FromGRPC(status.Error(codes.Code(result.Code), result.Error))
+				if errdefs.IsNotFound(err) {
+					logrus.Debugf("image-remove: %q not found on %s", name, node)
+					continue
+				}
+				failed[name] = errors.Wrapf(err, "node %s", node)
+				continue
+			}
+			for _, ref := range result.Untagged {
+				fmt.Printf("Untagged: %s\n", ref)
+			}
+			if result.Deleted != "" {
+				fmt.Printf("Deleted: %s\n", result.Deleted)
+			}
+			removed[name] = true
+		}
+		return nil
 	})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, name := range names {
+		switch {
+		case failed[name] != nil:
+			errs = append(errs, failed[name])
+		case !removed[name]:
+			errs = append(errs, errors.Errorf("image %q: not found", name))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
 }
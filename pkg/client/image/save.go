@@ -0,0 +1,93 @@
+package image
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/containerd/containerd/platforms"
+	"github.com/moby/term"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/progress"
+	"golang.org/x/sync/errgroup"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+)
+
+type Save struct {
+	Output       string `usage:"Write to a file, instead of STDOUT" short:"o"`
+	Platform     string `usage:"Save content for a specific platform (default is that of the builder)"`
+	AllPlatforms bool   `usage:"Save content for all platforms"`
+}
+
+func (s *Save) Do(ctx context.Context, k8s *client.Interface, names []string) error {
+	var out io.Writer = os.Stdout
+	if s.Output == "" {
+		if term.IsTerminal(os.Stdout.Fd()) {
+			return errors.New("cowardly refusing to save to a terminal. Use the -o flag or redirect")
+		}
+	} else {
+		f, err := os.Create(s.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	req := &imagesv1.ImageExportRequest{
+		AllPlatforms: s.AllPlatforms,
+	}
+	if s.Platform != "" {
+		platform, err := platforms.Parse(s.Platform)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse platform")
+		}
+		req.Platform = platforms.Format(platform)
+	}
+	return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+		for _, name := range names {
+			ref, err := refSpec(ctx, imagesClient, name)
+			if err != nil {
+				return err
+			}
+			if ref == nil {
+				return errors.Errorf("image %q: not found", name)
+			}
+			req.Images = append(req.Images, &criv1.ImageSpec{Image: ref.Image})
+		}
+		stream, err := imagesClient.Export(ctx, req)
+		if err != nil {
+			return err
+		}
+		xfer := newTransfer(s.Output, 0)
+		eg, ctx := errgroup.WithContext(ctx)
+		done, cancel := context.WithCancel(ctx)
+		// only render progress when not writing to stdout
+		if s.Output != "" {
+			ch := make(chan []imagesv1.ImageStatus)
+			eg.Go(func() error {
+				return progress.Display(ch, os.Stderr)
+			})
+			go xfer.report(done, ch, "saving")
+		}
+		eg.Go(func() error {
+			defer cancel()
+			for {
+				res, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				n, err := out.Write(res.Data)
+				if err != nil {
+					return err
+				}
+				xfer.Add(n)
+			}
+		})
+		return eg.Wait()
+	})
+}
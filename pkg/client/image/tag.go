@@ -3,7 +3,9 @@ package image
 import (
 	"context"
 
+	"github.com/containerd/containerd/errdefs"
 	"github.com/docker/distribution/reference"
+	"github.com/pkg/errors"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
 	"github.com/rancher/kim/pkg/client"
 	"github.com/sirupsen/logrus"
@@ -21,7 +23,8 @@ func (s *Tag) Do(ctx context.Context, k8s *client.Interface, image string, tags
 		}
 		normalizedTags[i] = reference.TagNameOnly(named).String()
 	}
-	return client.Images(ctx, k8s, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+	var tagged int
+	err := client.ImagesEach(ctx, k8s, func(ctx context.Context, node string, imagesClient imagesv1.ImagesClient) error {
 		ref, err := refSpec(ctx, imagesClient, image)
 		if err != nil {
 			return err
@@ -31,10 +34,19 @@ func (s *Tag) Do(ctx context.Context, k8s *client.Interface, image string, tags
 			Tags:  normalizedTags,
 		}
 		res, err := imagesClient.Tag(ctx, req)
+		if errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+			logrus.Debugf("image-tag: %q not found on %s", image, node)
+			return nil
+		}
 		if err != nil {
 			return err
 		}
 		logrus.Debugf("image-tag: %#v", res)
+		tagged++
 		return nil
 	})
+	if err == nil && tagged == 0 {
+		return errors.Errorf("image %q: not found", image)
+	}
+	return err
 }
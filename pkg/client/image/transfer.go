@@ -0,0 +1,63 @@
+package image
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+)
+
+// transfer counts the bytes of a tarball streamed to or from the agent, reporting progress in the same form as push
+// and pull so that it may be rendered via progress.Display
+type transfer struct {
+	ref       string
+	total     int64
+	offset    int64
+	startedAt time.Time
+}
+
+func newTransfer(ref string, total int64) *transfer {
+	return &transfer{
+		ref:       ref,
+		total:     total,
+		startedAt: time.Now(),
+	}
+}
+
+func (t *transfer) Add(n int) {
+	atomic.AddInt64(&t.offset, int64(n))
+}
+
+func (t *transfer) status(state string) []imagesv1.ImageStatus {
+	offset := atomic.LoadInt64(&t.offset)
+	total := t.total
+	if total < offset {
+		total = offset
+	}
+	return []imagesv1.ImageStatus{{
+		Ref:       t.ref,
+		Status:    state,
+		Offset:    offset,
+		Total:     total,
+		StartedAt: t.startedAt,
+		UpdatedAt: time.Now(),
+	}}
+}
+
+// report sends the transfer status to the channel until the context is done, at which point the final status is sent
+// and the channel is closed
+func (t *transfer) report(ctx context.Context, ch chan<- []imagesv1.ImageStatus, state string) {
+	defer close(ch)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			ch <- t.status("done")
+			return
+		case <-ticker.C:
+			ch <- t.status(state)
+		}
+	}
+}
@@ -4,50 +4,101 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"fmt"
+	"net"
 
 	"github.com/pkg/errors"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type ImagesFunc func(context.Context, imagesv1.ImagesClient) error
 
+// ImagesNodeFunc is invoked once per builder node by ImagesEach.
+type ImagesNodeFunc func(ctx context.Context, node string, imagesClient imagesv1.ImagesClient) error
+
+// Images invokes fn against the agent on the targeted builder node, or the first available.
 func Images(ctx context.Context, k8s *Interface, fn ImagesFunc) error {
-	addr, err := GetServiceAddress(ctx, k8s, "kim")
+	endpoint, err := GetServiceEndpoint(ctx, k8s, "kim")
 	if err != nil {
 		return err
 	}
+	return images(ctx, k8s, endpoint, fn)
+}
 
-	tlsConfig := &tls.Config{}
+// ImagesEach invokes fn against the agent on each builder node, in turn, or only the targeted builder node.
+func ImagesEach(ctx context.Context, k8s *Interface, fn ImagesNodeFunc) error {
+	endpoints, err := GetServiceEndpoints(ctx, k8s, "kim")
+	if err != nil {
+		return err
+	}
+	for _, endpoint := range endpoints {
+		node := endpoint.Node
+		err = images(ctx, k8s, endpoint, func(ctx context.Context, imagesClient imagesv1.ImagesClient) error {
+			return fn(ctx, node, imagesClient)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "node %s", node)
+		}
+	}
+	return nil
+}
 
-	// ca cert
-	secret, err := k8s.Core.Secret().Get(k8s.Namespace, "kim-tls-ca", metav1.GetOptions{})
+// ImagesAt invokes fn against the agent at the endpoint.
+func ImagesAt(ctx context.Context, k8s *Interface, endpoint Endpoint, fn ImagesFunc) error {
+	return images(ctx, k8s, endpoint, fn)
+}
+
+func images(ctx context.Context, k8s *Interface, endpoint Endpoint, fn ImagesFunc) error {
+	conn, err := dial(ctx, k8s, endpoint)
 	if err != nil {
-		return errors.Wrap(err, "failed to get ca cert")
+		return err
 	}
-	if pem, ok := secret.Data[corev1.TLSCertKey]; ok {
-		tlsConfig.RootCAs = x509.NewCertPool()
-		tlsConfig.RootCAs.AppendCertsFromPEM(pem)
+	defer conn.Close()
+	return fn(ctx, imagesv1.NewImagesClient(conn))
+}
+
+// dial connects to the agent at the endpoint with the client cert+key, and bearer token if any, verifying the agent
+// against the ca cert.
+func dial(ctx context.Context, k8s *Interface, endpoint Endpoint) (*grpc.ClientConn, error) {
+	tlsConfig := &tls.Config{
+		// the name of the service is valid however the agent is reached
+		ServerName: fmt.Sprintf("builder.%s.svc", k8s.Namespace),
 	}
 
 	// client cert+key
-	secret, err = k8s.Core.Secret().Get(k8s.Namespace, "kim-tls-client", metav1.GetOptions{})
+	secret, err := LoadClientCert(k8s)
 	if err != nil {
-		return errors.Wrap(err, "failed to get client cert+key")
+		return nil, errors.Wrap(err, "failed to get client cert+key")
 	}
 	certificate, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
 	if err != nil {
-		return errors.Wrap(err, "failed to setup client cert+key")
+		return nil, errors.Wrap(err, "failed to setup client cert+key")
 	}
 	tlsConfig.Certificates = []tls.Certificate{certificate}
 
-	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	// ca cert
+	pem, err := LoadCABundle(k8s, secret)
 	if err != nil {
-		return err
+		return nil, errors.Wrap(err, "failed to get ca cert")
 	}
-	defer conn.Close()
-	return fn(ctx, imagesv1.NewImagesClient(conn))
+	tlsConfig.RootCAs = x509.NewCertPool()
+	tlsConfig.RootCAs.AppendCertsFromPEM(pem)
+
+	options := []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return DialEndpoint(ctx, k8s, endpoint)
+		}),
+	}
+	// bearer token, for agents that authorize requests with Kubernetes RBAC
+	if token, err := k8s.BearerToken(); err != nil {
+		logrus.Debugf("skipping bearer token with error: %v", err)
+	} else if token != "" {
+		options = append(options, grpc.WithPerRPCCredentials(tokenCredentials(token)))
+	}
+	return grpc.DialContext(ctx, endpoint.Address, options...)
 }
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+)
+
+// JobsNodeFunc is invoked once per builder node by JobsEach.
+type JobsNodeFunc func(ctx context.Context, node string, jobsClient imagesv1.JobsClient) error
+
+// JobsEach invokes fn against the agent jobs service on each builder node, in turn, or only the targeted builder node.
+func JobsEach(ctx context.Context, k8s *Interface, fn JobsNodeFunc) error {
+	endpoints, err := GetServiceEndpoints(ctx, k8s, "kim")
+	if err != nil {
+		return err
+	}
+	for _, endpoint := range endpoints {
+		conn, err := dial(ctx, k8s, endpoint)
+		if err != nil {
+			return errors.Wrapf(err, "node %s", endpoint.Node)
+		}
+		err = fn(ctx, endpoint.Node, imagesv1.NewJobsClient(conn))
+		conn.Close()
+		if err != nil {
+			return errors.Wrapf(err, "node %s", endpoint.Node)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// Cancel running jobs.
+type Cancel struct {
+}
+
+func (s *Cancel) Do(ctx context.Context, k8s *client.Interface, ids []string) error {
+	var errs []error
+	for _, id := range ids {
+		err := find(ctx, k8s, id, func(ctx context.Context, node string, jobsClient imagesv1.JobsClient, job *imagesv1.Job) error {
+			if _, err := jobsClient.Cancel(ctx, &imagesv1.JobCancelRequest{Id: id}); err != nil {
+				return err
+			}
+			fmt.Println(id)
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+)
+
+// jobFunc is invoked against the builder node that is running, or ran, the job.
+type jobFunc func(ctx context.Context, node string, jobsClient imagesv1.JobsClient, job *imagesv1.Job) error
+
+// find locates the job on the builder nodes, invoking fn against the node it was found on.
+func find(ctx context.Context, k8s *client.Interface, id string, fn jobFunc) error {
+	var found bool
+	err := client.JobsEach(ctx, k8s, func(ctx context.Context, node string, jobsClient imagesv1.JobsClient) error {
+		if found {
+			return nil
+		}
+		res, err := jobsClient.Get(ctx, &imagesv1.JobGetRequest{Id: id})
+		if errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+		return fn(ctx, node, jobsClient, res.Job)
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.Errorf("job %q: not found", id)
+	}
+	return nil
+}
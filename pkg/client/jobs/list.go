@@ -0,0 +1,73 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/go-units"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+)
+
+// List the builds, pulls and pushes on the builder(s).
+type List struct {
+	All   bool `usage:"Show all jobs (default shows just running)" short:"a"`
+	Quiet bool `usage:"Only show job IDs" short:"q"`
+}
+
+func (s *List) Do(ctx context.Context, k8s *client.Interface) error {
+	type nodeJobs struct {
+		node string
+		jobs []*imagesv1.Job
+	}
+	var results []nodeJobs
+	err := client.JobsEach(ctx, k8s, func(ctx context.Context, node string, jobsClient imagesv1.JobsClient) error {
+		res, err := jobsClient.List(ctx, &imagesv1.JobListRequest{All: s.All})
+		if err != nil {
+			return err
+		}
+		results = append(results, nodeJobs{node: node, jobs: res.Jobs})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if s.Quiet {
+		for _, result := range results {
+			for _, job := range result.jobs {
+				fmt.Println(job.Id)
+			}
+		}
+		return nil
+	}
+
+	// only show the node column when querying more than one builder
+	showNode := len(results) > 1
+
+	w := tabwriter.NewWriter(os.Stdout, 1, 8, 3, ' ', 0)
+	var header []string
+	if showNode {
+		header = append(header, "NODE")
+	}
+	header = append(header, "JOB ID", "KIND", "IMAGE", "OWNER", "STATE", "CREATED")
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, result := range results {
+		for _, job := range result.jobs {
+			var row []string
+			if showNode {
+				row = append(row, result.node)
+			}
+			state := job.State
+			if job.Error != "" {
+				state = fmt.Sprintf("%s: %s", state, job.Error)
+			}
+			row = append(row, job.Id, job.Kind, job.Image, job.Owner, state, units.HumanDuration(time.Since(job.CreatedAt))+" ago")
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+	}
+	return w.Flush()
+}
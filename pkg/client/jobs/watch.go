@@ -0,0 +1,93 @@
+package jobs
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/containerd/console"
+	buildkit "github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/util/progress/progressui"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/progress"
+	"golang.org/x/sync/errgroup"
+)
+
+// Watch re-attaches to a job, displaying its progress until it is finished.
+type Watch struct {
+	Progress string `usage:"Set type of progress output (auto, plain, tty) for builds" default:"auto"`
+}
+
+func (s *Watch) Do(ctx context.Context, k8s *client.Interface, id string) error {
+	return find(ctx, k8s, id, func(ctx context.Context, node string, jobsClient imagesv1.JobsClient, job *imagesv1.Job) error {
+		stream, err := jobsClient.Watch(ctx, &imagesv1.JobWatchRequest{Id: id})
+		if err != nil {
+			return err
+		}
+		var (
+			eg         errgroup.Group
+			buildCh    chan *buildkit.SolveStatus
+			progressCh chan []imagesv1.ImageStatus
+		)
+		// render output from the channel
+		if job.Kind == "build" {
+			var c console.Console
+			if s.Progress != "plain" {
+				if c, err = console.ConsoleFromFile(os.Stderr); err != nil {
+					c = nil
+				}
+			}
+			buildCh = make(chan *buildkit.SolveStatus, 1)
+			eg.Go(func() error {
+				return progressui.DisplaySolveStatus(context.TODO(), "", c, os.Stdout, buildCh)
+			})
+		} else {
+			progressCh = make(chan []imagesv1.ImageStatus)
+			eg.Go(func() error {
+				return progress.Display(progressCh, os.Stdout)
+			})
+		}
+		// render progress to the channel
+		last := job
+		eg.Go(func() error {
+			defer func() {
+				if buildCh != nil {
+					close(buildCh)
+				}
+				if progressCh != nil {
+					close(progressCh)
+				}
+			}()
+			for {
+				res, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if res.Job != nil {
+					last = res.Job
+				}
+				if res.Build != nil && buildCh != nil {
+					buildCh <- progress.SolveStatus(res.Build)
+				}
+				if len(res.Progress) > 0 && progressCh != nil {
+					progressCh <- res.Progress
+				}
+			}
+		})
+		if err = eg.Wait(); err != nil {
+			return err
+		}
+		switch last.State {
+		case "failed":
+			return errors.Errorf("job %s failed: %s", id, last.Error)
+		case "cancelled":
+			return errors.Errorf("job %s was cancelled", id)
+		}
+		return nil
+	})
+}
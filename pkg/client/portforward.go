@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+const (
+	// TransportPortForward tunnels connections to the builder through port-forwards by the Kubernetes API server, and
+	// so works wherever kubectl does.
+	TransportPortForward = "port-forward"
+	// TransportDirect connects to the addresses of the builder pods, or the endpoint-override of the service.
+	TransportDirect = "direct"
+)
+
+// DialEndpoint connects to the endpoint, through a port-forward to its pod or directly, as per the transport.
+func DialEndpoint(ctx context.Context, k8s *Interface, endpoint Endpoint) (net.Conn, error) {
+	switch k8s.Transport {
+	case TransportDirect:
+		return (&net.Dialer{}).DialContext(ctx, "tcp", endpoint.Address)
+	case TransportPortForward, "":
+		return portForward(ctx, k8s, endpoint)
+	}
+	return nil, errors.Errorf("unknown transport %q, must be %s or %s", k8s.Transport, TransportPortForward, TransportDirect)
+}
+
+// portForward opens a connection to the port of the endpoint's pod over an SPDY stream of the Kubernetes API server.
+func portForward(ctx context.Context, k8s *Interface, endpoint Endpoint) (net.Conn, error) {
+	if endpoint.Pod == "" {
+		return nil, errors.Errorf("no builder pod behind %s on node %s", endpoint.Address, endpoint.Node)
+	}
+	if k8s.config == nil {
+		return nil, errors.New("port-forward requires a kubeconfig")
+	}
+	core, err := typedcorev1.NewForConfig(k8s.config)
+	if err != nil {
+		return nil, err
+	}
+	roundTripper, upgrader, err := spdy.RoundTripperFor(k8s.config)
+	if err != nil {
+		return nil, err
+	}
+	url := core.RESTClient().Post().
+		Namespace(k8s.Namespace).
+		Resource("pods").
+		Name(endpoint.Pod).
+		SubResource("portforward").
+		URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: roundTripper}, http.MethodPost, url)
+
+	type dialed struct {
+		conn httpstream.Connection
+		err  error
+	}
+	result := make(chan dialed, 1)
+	go func() {
+		conn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+		result <- dialed{conn, err}
+	}()
+	var streamConn httpstream.Connection
+	select {
+	case <-ctx.Done():
+		go func() {
+			if d := <-result; d.conn != nil {
+				d.conn.Close()
+			}
+		}()
+		return nil, ctx.Err()
+	case d := <-result:
+		if d.err != nil {
+			return nil, errors.Wrapf(d.err, "failed to port-forward to pod %s", endpoint.Pod)
+		}
+		streamConn = d.conn
+	}
+
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(int(endpoint.Port)))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+	errorStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		streamConn.Close()
+		return nil, errors.Wrapf(err, "failed to create error stream to pod %s", endpoint.Pod)
+	}
+	// the error stream is only ever read from
+	errorStream.Close()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		streamConn.Close()
+		return nil, errors.Wrapf(err, "failed to create data stream to pod %s", endpoint.Pod)
+	}
+
+	conn := &forwardedConn{
+		Stream:     dataStream,
+		streamConn: streamConn,
+		remote:     forwardedAddr(fmt.Sprintf("%s/%s:%d", k8s.Namespace, endpoint.Pod, endpoint.Port)),
+	}
+	go func() {
+		message, err := ioutil.ReadAll(errorStream)
+		switch {
+		case err != nil:
+			logrus.Debugf("failed to read port-forward error stream of %s: %v", conn.remote, err)
+		case len(message) > 0:
+			logrus.Debugf("port-forward to %s failed: %s", conn.remote, message)
+			conn.Close()
+		}
+	}()
+	return conn, nil
+}
+
+// forwardedConn is a connection over the data stream of a port-forward, which has no deadlines.
+type forwardedConn struct {
+	httpstream.Stream
+	streamConn httpstream.Connection
+	remote     forwardedAddr
+	closeOnce  sync.Once
+}
+
+func (c *forwardedConn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		c.Stream.Reset()
+		err = c.streamConn.Close()
+	})
+	return err
+}
+
+func (c *forwardedConn) LocalAddr() net.Addr {
+	return forwardedAddr("port-forward")
+}
+
+func (c *forwardedConn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+func (c *forwardedConn) SetDeadline(time.Time) error {
+	return nil
+}
+
+func (c *forwardedConn) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+func (c *forwardedConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+type forwardedAddr string
+
+func (a forwardedAddr) Network() string {
+	return TransportPortForward
+}
+
+func (a forwardedAddr) String() string {
+	return string(a)
+}
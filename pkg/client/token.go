@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"k8s.io/client-go/transport"
+)
+
+// BearerToken returns the bearer token that the kubeconfig authenticates with, if any, including those provided by
+// exec and auth-provider plugins. Kubeconfigs that authenticate with client certificates have none.
+func (k *Interface) BearerToken() (string, error) {
+	if k.config == nil {
+		return "", nil
+	}
+	tc, err := k.config.TransportConfig()
+	if err != nil {
+		return "", err
+	}
+	captured := &authorizationCapture{}
+	rt, err := transport.HTTPWrappersForConfig(tc, captured)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://kubernetes.default.svc/version", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if !strings.HasPrefix(captured.authorization, "Bearer ") {
+		return "", nil
+	}
+	return strings.TrimPrefix(captured.authorization, "Bearer "), nil
+}
+
+// authorizationCapture records the Authorization header that the kubeconfig wrappers set, without sending the request.
+type authorizationCapture struct {
+	authorization string
+}
+
+func (c *authorizationCapture) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.authorization = req.Header.Get("Authorization")
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+// tokenCredentials sends the bearer token of the kubeconfig with each RPC so that the agent can authenticate the
+// caller with a TokenReview.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
@@ -0,0 +1,254 @@
+package progress
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/snapshots"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/identity"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// PullTracker reports the progress of a single pull, limited to the content that it fetches.
+type PullTracker interface {
+	// Add content fetched by the pull, keyed by the ref of its content store ingest
+	Add(ref string, desc ocispec.Descriptor)
+	// Done marks the pull as complete, a final status is reported before the status channel is closed
+	Done()
+	Status() <-chan []imagesv1.ImageStatus
+}
+
+type pullTracker struct {
+	*pulljobs
+	status chan []imagesv1.ImageStatus
+}
+
+func (t *pullTracker) Status() <-chan []imagesv1.ImageStatus {
+	return t.status
+}
+
+// NewPullTracker tracks the content of a pull until it is done or the context is cancelled. Layers are reported as
+// extracting once fetched and until their snapshot exists. The status is read with the namespace of statusCtx so that
+// the final status can still be reported after the pull context is cancelled.
+func NewPullTracker(ctx, statusCtx context.Context, name string, store content.Store, snapshotter snapshots.Snapshotter) PullTracker {
+	ongoing := newPullJobs(name, store, snapshotter)
+
+	var (
+		result = make(chan []imagesv1.ImageStatus)
+	)
+
+	go func() {
+		ticker := time.NewTicker(100 * time.Millisecond)
+
+		defer ticker.Stop()
+		defer close(result)
+
+		for {
+			select {
+			case <-ticker.C:
+				select {
+				case result <- ongoing.status(statusCtx):
+					continue
+				case <-ongoing.done:
+				case <-ctx.Done():
+				}
+			case <-ongoing.done:
+			case <-ctx.Done():
+			}
+			// nobody may be listening for the final status
+			select {
+			case result <- ongoing.status(statusCtx):
+			case <-time.After(time.Second):
+			}
+			return
+		}
+	}()
+
+	return &pullTracker{
+		pulljobs: ongoing,
+		status:   result,
+	}
+}
+
+type pulljobs struct {
+	name        string
+	jobs        map[string]ocispec.Descriptor
+	ordered     []string
+	manifests   []ocispec.Descriptor
+	chainIDs    map[digest.Digest]digest.Digest
+	store       content.Store
+	snapshotter snapshots.Snapshotter
+	done        chan struct{}
+	once        sync.Once
+	mu          sync.Mutex
+}
+
+func newPullJobs(name string, store content.Store, snapshotter snapshots.Snapshotter) *pulljobs {
+	return &pulljobs{
+		name:        name,
+		jobs:        make(map[string]ocispec.Descriptor),
+		chainIDs:    make(map[digest.Digest]digest.Digest),
+		store:       store,
+		snapshotter: snapshotter,
+		done:        make(chan struct{}),
+	}
+}
+
+func (j *pulljobs) Add(ref string, desc ocispec.Descriptor) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, ok := j.jobs[ref]; ok {
+		return
+	}
+	j.ordered = append(j.ordered, ref)
+	j.jobs[ref] = desc
+	if images.IsManifestType(desc.MediaType) {
+		j.manifests = append(j.manifests, desc)
+	}
+}
+
+func (j *pulljobs) Done() {
+	j.once.Do(func() {
+		close(j.done)
+	})
+}
+
+func (j *pulljobs) isDone() bool {
+	select {
+	case <-j.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (j *pulljobs) status(ctx context.Context) []imagesv1.ImageStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	done := j.isDone()
+	if len(j.ordered) == 0 {
+		// nothing fetched through the tracker, e.g. pulls via the CRI
+		si := imagesv1.ImageStatus{
+			Ref:    j.name,
+			Status: "resolving",
+		}
+		if done {
+			si.Status = "done"
+		}
+		return []imagesv1.ImageStatus{si}
+	}
+
+	active := map[string]content.Status{}
+	statuses, err := j.store.ListStatuses(ctx)
+	if err != nil {
+		logrus.Debugf("pull-progress-content-status-error: %v", err)
+	}
+	for _, status := range statuses {
+		active[status.Ref] = status
+	}
+	j.resolveChainIDs(ctx)
+
+	result := make([]imagesv1.ImageStatus, 0, len(j.jobs))
+	for _, ref := range j.ordered {
+		desc := j.jobs[ref]
+		si := imagesv1.ImageStatus{
+			Ref:   ref,
+			Total: desc.Size,
+		}
+		if status, ok := active[ref]; ok && !done {
+			si.Status = "downloading"
+			si.Offset = status.Offset
+			si.Total = status.Total
+			si.StartedAt = status.StartedAt
+			si.UpdatedAt = status.UpdatedAt
+			result = append(result, si)
+			continue
+		}
+		info, err := j.store.Info(ctx, desc.Digest)
+		switch {
+		case done:
+			si.Status = "done"
+			si.Offset = si.Total
+		case errdefs.IsNotFound(err):
+			si.Status = "waiting"
+		case err != nil:
+			logrus.Debugf("pull-progress-content-info-error: %s -> %v", ref, err)
+			si.Status = "waiting"
+		case j.extracting(ctx, ref):
+			si.Status = "extracting"
+			si.Offset = info.Size
+			si.StartedAt = info.CreatedAt
+			si.UpdatedAt = info.UpdatedAt
+		default:
+			si.Status = "done"
+			si.Offset = info.Size
+			si.StartedAt = info.CreatedAt
+			si.UpdatedAt = info.UpdatedAt
+		}
+		result = append(result, si)
+	}
+
+	return result
+}
+
+// extracting returns true if the ref is a layer without a snapshot
+func (j *pulljobs) extracting(ctx context.Context, ref string) bool {
+	if !images.IsLayerType(j.jobs[ref].MediaType) {
+		return false
+	}
+	chainID, ok := j.chainIDs[j.jobs[ref].Digest]
+	if !ok {
+		return true
+	}
+	if _, err := j.snapshotter.Stat(ctx, chainID.String()); err != nil {
+		return true
+	}
+	return false
+}
+
+// resolveChainIDs reads the layers of the fetched manifests and the diff ids from their configs to determine the
+// snapshot of each layer. Children are fetched concurrently so the order that layers are added in is meaningless.
+func (j *pulljobs) resolveChainIDs(ctx context.Context) {
+	for _, desc := range j.manifests {
+		p, err := content.ReadBlob(ctx, j.store, desc)
+		if err != nil {
+			continue // not yet fetched
+		}
+		var manifest ocispec.Manifest
+		if err = json.Unmarshal(p, &manifest); err != nil {
+			logrus.Debugf("pull-progress-manifest-error: %s -> %v", desc.Digest, err)
+			continue
+		}
+		if len(manifest.Layers) == 0 {
+			continue
+		}
+		if _, ok := j.chainIDs[manifest.Layers[len(manifest.Layers)-1].Digest]; ok {
+			continue // already resolved
+		}
+		if p, err = content.ReadBlob(ctx, j.store, manifest.Config); err != nil {
+			continue // not yet fetched
+		}
+		var config ocispec.Image
+		if err = json.Unmarshal(p, &config); err != nil {
+			logrus.Debugf("pull-progress-config-error: %s -> %v", manifest.Config.Digest, err)
+			continue
+		}
+		diffIDs := config.RootFS.DiffIDs
+		if len(diffIDs) != len(manifest.Layers) {
+			continue
+		}
+		for i, chainID := range identity.ChainIDs(append([]digest.Digest{}, diffIDs...)) {
+			j.chainIDs[manifest.Layers[i].Digest] = chainID
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package progress
+
+import (
+	buildkit "github.com/moby/buildkit/client"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+)
+
+// SolveStatus converts build status, as relayed by the agent, for display by the buildkit progress ui.
+func SolveStatus(resp *imagesv1.ImageBuildStatusResponse) *buildkit.SolveStatus {
+	s := buildkit.SolveStatus{}
+	for _, v := range resp.Vertexes {
+		s.Vertexes = append(s.Vertexes, &buildkit.Vertex{
+			Digest:    v.Digest,
+			Inputs:    v.Inputs,
+			Name:      v.Name,
+			Started:   v.Started,
+			Completed: v.Completed,
+			Error:     v.Error,
+			Cached:    v.Cached,
+		})
+	}
+	for _, v := range resp.Statuses {
+		s.Statuses = append(s.Statuses, &buildkit.VertexStatus{
+			ID:        v.ID,
+			Vertex:    v.Vertex,
+			Name:      v.Name,
+			Total:     v.Total,
+			Current:   v.Current,
+			Timestamp: v.Timestamp,
+			Started:   v.Started,
+			Completed: v.Completed,
+		})
+	}
+	for _, v := range resp.Logs {
+		s.Logs = append(s.Logs, &buildkit.VertexLog{
+			Vertex:    v.Vertex,
+			Stream:    int(v.Stream),
+			Data:      v.Msg,
+			Timestamp: v.Timestamp,
+		})
+	}
+	return &s
+}
@@ -0,0 +1,188 @@
+package registries
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/pkg/errors"
+)
+
+// AuthorizerFunc returns the authorizer for a host, fetching tokens with the client configured for that host.
+type AuthorizerFunc func(client *http.Client) docker.Authorizer
+
+// Hosts returns the hosts to resolve, pull and push against for a registry: its mirror endpoints, which may only be
+// pulled from, followed by the registry itself. As with k3s, a mirror endpoint of the registry's own host stands in
+// for the registry, which is how registries served over plain HTTP are configured, e.g. http://registry.local:5000
+// mirroring registry.local:5000.
+func (r *Registry) Hosts(authorizer AuthorizerFunc) docker.RegistryHosts {
+	return func(host string) ([]docker.RegistryHost, error) {
+		var hosts []docker.RegistryHost
+		self := false
+		if mirror, ok := r.Mirror(host); ok {
+			rewrites, err := compileRewrites(mirror.Rewrites)
+			if err != nil {
+				return nil, err
+			}
+			for _, endpoint := range mirror.Endpoints {
+				h, err := r.host(endpoint, rewrites, authorizer)
+				if err != nil {
+					return nil, err
+				}
+				h.Capabilities = docker.HostCapabilityPull | docker.HostCapabilityResolve
+				if h.Host == registryHost(host) && len(rewrites) == 0 {
+					h.Capabilities |= docker.HostCapabilityPush
+					self = true
+				}
+				hosts = append(hosts, h)
+			}
+		}
+		if self {
+			return hosts, nil
+		}
+		scheme := "https"
+		if ok, _ := docker.MatchLocalhost(host); ok {
+			scheme = "http"
+		}
+		h, err := r.host(scheme+"://"+registryHost(host), nil, authorizer)
+		if err != nil {
+			return nil, err
+		}
+		h.Capabilities = docker.HostCapabilityPull | docker.HostCapabilityResolve | docker.HostCapabilityPush
+		return append(hosts, h), nil
+	}
+}
+
+// registryHost returns the host that the registry is served from, registry-1.docker.io for docker.io.
+func registryHost(host string) string {
+	if host == "docker.io" {
+		return "registry-1.docker.io"
+	}
+	return host
+}
+
+// host configures the endpoint, with the TLS configuration for its host.
+func (r *Registry) host(endpoint string, rewrites []rewrite, authorizer AuthorizerFunc) (docker.RegistryHost, error) {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return docker.RegistryHost{}, errors.Wrapf(err, "invalid endpoint %q", endpoint)
+	}
+	path := strings.TrimSuffix(u.Path, "/")
+	if !strings.HasSuffix(path, "/v2") {
+		path += "/v2"
+	}
+	tlsConfig, err := r.tlsConfig(u.Host)
+	if err != nil {
+		return docker.RegistryHost{}, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	client := &http.Client{Transport: transport}
+	if len(rewrites) > 0 {
+		client = &http.Client{Transport: &rewriteTransport{base: transport, prefix: path, rewrites: rewrites}}
+	}
+	return docker.RegistryHost{
+		Client:     client,
+		Authorizer: authorizer(&http.Client{Transport: transport}),
+		Host:       u.Host,
+		Scheme:     u.Scheme,
+		Path:       path,
+	}, nil
+}
+
+// tlsConfig returns the TLS configuration for the host, or nil for the defaults.
+func (r *Registry) tlsConfig(host string) (*tls.Config, error) {
+	config, ok := r.Configs[host]
+	if !ok || config.TLS == nil {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.TLS.InsecureSkipVerify,
+	}
+	if config.TLS.CAFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := ioutil.ReadFile(config.TLS.CAFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read ca for %s", host)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("failed to append ca for %s", host)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.TLS.CertFile != "" || config.TLS.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(config.TLS.CertFile, config.TLS.KeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load client cert+key for %s", host)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+type rewrite struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// compileRewrites in a stable order, the first that matches a repository is applied.
+func compileRewrites(rewrites map[string]string) ([]rewrite, error) {
+	patterns := make([]string, 0, len(rewrites))
+	for pattern := range rewrites {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	var result []rewrite
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid rewrite %q", pattern)
+		}
+		result = append(result, rewrite{pattern: re, replacement: rewrites[pattern]})
+	}
+	return result, nil
+}
+
+// rewriteTransport rewrites the repository in the path of requests to a mirror, e.g. /v2/REPOSITORY/manifests/TAG
+type rewriteTransport struct {
+	base     http.RoundTripper
+	prefix   string
+	rewrites []rewrite
+}
+
+func (t *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rest := strings.TrimPrefix(req.URL.Path, t.prefix+"/")
+	if rest == req.URL.Path {
+		return t.base.RoundTrip(req)
+	}
+	for _, kind := range []string{"/manifests/", "/blobs/", "/tags/"} {
+		i := strings.LastIndex(rest, kind)
+		if i <= 0 {
+			continue
+		}
+		repository := rest[:i]
+		for _, rw := range t.rewrites {
+			if rw.pattern.MatchString(repository) {
+				repository = rw.pattern.ReplaceAllString(repository, rw.replacement)
+				break
+			}
+		}
+		req = req.Clone(req.Context())
+		req.URL.Path = t.prefix + "/" + repository + rest[i:]
+		req.URL.RawPath = ""
+		break
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,163 @@
+package registries
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRewriteTransport(t *testing.T) {
+	rewrites, err := compileRewrites(map[string]string{
+		"^library/(.*)": "mirror/docker-hub/$1",
+		"^rancher/(.*)": "mirror/rancher/$1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name   string
+		prefix string
+		path   string
+		want   string
+	}{
+		{"manifests", "/v2", "/v2/library/busybox/manifests/latest", "/v2/mirror/docker-hub/busybox/manifests/latest"},
+		{"blobs", "/v2", "/v2/rancher/kim/blobs/sha256:abc", "/v2/mirror/rancher/kim/blobs/sha256:abc"},
+		{"tags", "/v2", "/v2/library/busybox/tags/list", "/v2/mirror/docker-hub/busybox/tags/list"},
+		{"nested repository", "/v2", "/v2/rancher/sub/repo/manifests/v1", "/v2/mirror/rancher/sub/repo/manifests/v1"},
+		{"mirror path", "/cache/v2", "/cache/v2/library/busybox/manifests/latest", "/cache/v2/mirror/docker-hub/busybox/manifests/latest"},
+		{"no matching rewrite", "/v2", "/v2/other/image/manifests/latest", "/v2/other/image/manifests/latest"},
+		{"repository named like a kind", "/v2", "/v2/library/blobs/manifests/latest", "/v2/mirror/docker-hub/blobs/manifests/latest"},
+		{"api version check", "/v2", "/v2/", "/v2/"},
+		{"outside of prefix", "/cache/v2", "/v2/library/busybox/manifests/latest", "/v2/library/busybox/manifests/latest"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got string
+			transport := &rewriteTransport{
+				base: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					got = req.URL.Path
+					return &http.Response{StatusCode: http.StatusOK}, nil
+				}),
+				prefix:   tc.prefix,
+				rewrites: rewrites,
+			}
+			req := &http.Request{Method: http.MethodGet, URL: &url.URL{Scheme: "https", Host: "mirror.example.com", Path: tc.path}}
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+			if req.URL.Path != tc.path {
+				t.Errorf("original request modified: %q", req.URL.Path)
+			}
+		})
+	}
+}
+
+func TestHostsPlainHTTPRegistry(t *testing.T) {
+	const host = "registry.kim.test:5000"
+	registry, err := Parse([]byte(`
+mirrors:
+  "registry.kim.test:5000":
+    endpoint:
+    - http://registry.kim.test:5000
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hosts, err := registry.Hosts(func(*http.Client) docker.Authorizer { return nil })(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 1 {
+		t.Fatalf("got %d hosts, want the mirror endpoint standing in for the registry", len(hosts))
+	}
+	if hosts[0].Scheme != "http" || hosts[0].Host != host || !hosts[0].Capabilities.Has(docker.HostCapabilityPush) {
+		t.Fatalf("got %s://%s with capabilities %b, want http://%s that may be pushed to", hosts[0].Scheme, hosts[0].Host, hosts[0].Capabilities, host)
+	}
+
+	// a plain HTTP registry that accepts uploads, reached in place of registry.kim.test
+	var mu sync.Mutex
+	pushed := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case req.Method == http.MethodHead:
+			w.WriteHeader(http.StatusNotFound)
+		case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/blobs/uploads/"):
+			w.Header().Set("Location", req.URL.Path+"upload")
+			w.WriteHeader(http.StatusAccepted)
+		case req.Method == http.MethodPut && strings.HasSuffix(req.URL.Path, "/blobs/uploads/upload"):
+			ioutil.ReadAll(req.Body)
+			pushed[req.URL.Query().Get("digest")] = true
+			w.Header().Set("Docker-Content-Digest", req.URL.Query().Get("digest"))
+			w.WriteHeader(http.StatusCreated)
+		case req.Method == http.MethodPut && strings.Contains(req.URL.Path, "/manifests/"):
+			data, _ := ioutil.ReadAll(req.Body)
+			pushed[req.URL.Path] = true
+			w.Header().Set("Docker-Content-Digest", digest.FromBytes(data).String())
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	transport := hosts[0].Client.Transport.(*http.Transport)
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+
+	resolver := docker.NewResolver(docker.ResolverOptions{
+		Hosts: func(string) ([]docker.RegistryHost, error) { return hosts, nil },
+	})
+	ctx := context.Background()
+	pusher, err := resolver.Pusher(ctx, host+"/kim/test:latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := []byte(`{"architecture":"amd64","os":"linux","rootfs":{"type":"layers","diff_ids":[]}}`)
+	configDesc := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageConfig, Digest: digest.FromBytes(config), Size: int64(len(config))}
+	manifest, err := json.Marshal(ocispec.Manifest{Versioned: specs.Versioned{SchemaVersion: 2}, Config: configDesc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifestDesc := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageManifest, Digest: digest.FromBytes(manifest), Size: int64(len(manifest))}
+	for _, blob := range []struct {
+		desc ocispec.Descriptor
+		data []byte
+	}{{configDesc, config}, {manifestDesc, manifest}} {
+		w, err := pusher.Push(ctx, blob.desc)
+		if err != nil {
+			t.Fatalf("push %s: %v", blob.desc.MediaType, err)
+		}
+		if err = content.Copy(ctx, w, bytes.NewReader(blob.data), blob.desc.Size, blob.desc.Digest); err != nil {
+			t.Fatalf("push %s: %v", blob.desc.MediaType, err)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !pushed[configDesc.Digest.String()] || !pushed["/v2/kim/test/manifests/latest"] {
+		t.Errorf("registry did not receive the config and manifest, got %v", pushed)
+	}
+}
@@ -0,0 +1,98 @@
+package registries
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultFile is where k3s looks for its registry configuration on each node.
+	DefaultFile = "/etc/rancher/k3s/registries.yaml"
+)
+
+// Registry is the mirror and registry configuration, in the format of the k3s registries.yaml
+type Registry struct {
+	// Mirrors are keyed by registry host, "*" applying to all registries without their own
+	Mirrors map[string]Mirror `json:"mirrors"`
+	// Configs are keyed by registry, or mirror, host
+	Configs map[string]RegistryConfig `json:"configs"`
+}
+
+// Mirror endpoints are tried in order before the registry itself.
+type Mirror struct {
+	Endpoints []string `json:"endpoint"`
+	// Rewrites map repository regular expressions to their replacement when pulling from a mirror
+	Rewrites map[string]string `json:"rewrite"`
+}
+
+type RegistryConfig struct {
+	Auth *AuthConfig `json:"auth"`
+	TLS  *TLSConfig  `json:"tls"`
+}
+
+type AuthConfig struct {
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	Auth          string `json:"auth"`
+	IdentityToken string `json:"identity_token"`
+}
+
+// TLSConfig files are paths on the builder node(s).
+type TLSConfig struct {
+	CAFile             string `json:"ca_file"`
+	CertFile           string `json:"cert_file"`
+	KeyFile            string `json:"key_file"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify"`
+}
+
+// Load the registry configuration from the file, which need not exist.
+func Load(file string) (*Registry, error) {
+	if file == "" {
+		return &Registry{}, nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return &Registry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse the registry configuration.
+func Parse(data []byte) (*Registry, error) {
+	registry := &Registry{}
+	if err := yaml.Unmarshal(data, registry); err != nil {
+		return nil, errors.Wrap(err, "failed to parse registries config")
+	}
+	return registry, nil
+}
+
+// Mirror returns the mirror configured for the registry host.
+func (r *Registry) Mirror(host string) (Mirror, bool) {
+	if mirror, ok := r.Mirrors[host]; ok {
+		return mirror, true
+	}
+	mirror, ok := r.Mirrors["*"]
+	return mirror, ok
+}
+
+// Files returns the TLS files referenced by the configuration.
+func (r *Registry) Files() []string {
+	var files []string
+	for _, config := range r.Configs {
+		if config.TLS == nil {
+			continue
+		}
+		for _, file := range []string{config.TLS.CAFile, config.TLS.CertFile, config.TLS.KeyFile} {
+			if file != "" {
+				files = append(files, file)
+			}
+		}
+	}
+	return files
+}
@@ -2,7 +2,9 @@ package server
 
 type Agent struct {
 	Config
-	Tlscacert string `usage:"ca certificate to verify clients"`
-	Tlscert   string `usage:"server tls certificate"`
-	Tlskey    string `usage:"server tls key"`
+	Tlscacert  string `usage:"ca certificate to verify clients"`
+	Tlscert    string `usage:"server tls certificate"`
+	Tlskey     string `usage:"server tls key"`
+	Registries string `usage:"registries config (k3s registries.yaml format) with mirrors and TLS for pulls and pushes" default:"/etc/rancher/k3s/registries.yaml"`
+	TokenAuth  bool   `usage:"authenticate clients by their kubernetes bearer tokens and authorize them with kubernetes rbac"`
 }
@@ -2,11 +2,9 @@ package server
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"io/ioutil"
 	"net"
+	"os"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/api/events"
@@ -27,11 +25,16 @@ import (
 )
 
 func (a *Agent) Run(ctx context.Context) error {
-	backend, err := a.Interface(ctx, &client.DefaultConfig)
+	config := client.DefaultConfig
+	if namespace := os.Getenv("NAMESPACE"); namespace != "" {
+		config.Namespace = namespace
+	}
+	backend, err := a.Interface(ctx, &config)
 	if err != nil {
 		return err
 	}
 	defer backend.Close()
+	backend.Registries = a.Registries
 
 	go a.syncImageContent(namespaces.WithNamespace(ctx, buildkitNamespace), backend.Containerd)
 	go a.listenAndServe(ctx, backend)
@@ -52,29 +55,26 @@ func (a *Agent) listenAndServe(ctx context.Context, backend *imgsvr.Server) erro
 
 	var serverOptions []grpc.ServerOption
 	if a.Tlscert != "" && a.Tlskey != "" && a.Tlscacert != "" {
-		serverCert, err := tls.LoadX509KeyPair(a.Tlscert, a.Tlskey)
+		certs, err := newCertReloader(a.Tlscert, a.Tlskey, a.Tlscacert)
 		if err != nil {
 			return err
 		}
-		tlsConfig := &tls.Config{
-			Certificates: []tls.Certificate{serverCert},
-			ClientAuth:   tls.NoClientCert,
-		}
-		if a.Tlscacert != "" {
-			caCert, err := ioutil.ReadFile(a.Tlscacert)
-			if err != nil {
-				return err
-			}
-			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
-			tlsConfig.ClientCAs = x509.NewCertPool()
-			if ok := tlsConfig.ClientCAs.AppendCertsFromPEM(caCert); !ok {
-				return errors.New("failed to append ca certificate")
-			}
+		var authz *authorizer
+		if a.TokenAuth {
+			authz = newAuthorizer(backend.Kubernetes)
+		} else {
+			authz = newAuthorizer(nil)
 		}
-		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		backend.Authorizer = authz
+		serverOptions = append(serverOptions,
+			grpc.Creds(credentials.NewTLS(certs.TLSConfig())),
+			grpc.UnaryInterceptor(authz.unary),
+			grpc.StreamInterceptor(authz.stream),
+		)
 	}
 	server := grpc.NewServer(serverOptions...)
 	imagesv1.RegisterImagesServer(server, backend)
+	imagesv1.RegisterJobsServer(server, backend.Jobs())
 	defer server.Stop()
 	return server.Serve(listener)
 }
@@ -0,0 +1,327 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rancher/kim/pkg/client"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ReadOnlyGroup is the organization of the client certificates of users that may only list, inspect and watch.
+	ReadOnlyGroup = "kim:read-only"
+	// RBACGroup is the API group of the synthetic resources that RPCs are authorized against with token auth.
+	RBACGroup = "kim.cattle.io"
+	// CertUserPrefix prefixes the common name of client certificates to make the user they are authorized as with token
+	// auth, so that certificates cannot pass for Kubernetes users or groups.
+	CertUserPrefix = "kim:cert:"
+
+	tokenReviewTTL  = time.Minute
+	accessReviewTTL = 10 * time.Second
+)
+
+// rpcAttributes are the resource and verb that an RPC is authorized as
+type rpcAttributes struct {
+	resource string
+	verb     string
+}
+
+// ReadOnly returns true for RPCs that do not change the state of the builder.
+func (a rpcAttributes) ReadOnly() bool {
+	switch a.verb {
+	case "get", "list", "watch", "export":
+		return true
+	}
+	return false
+}
+
+// rpcs maps every RPC of the agent to the resource and verb that it is authorized as
+var rpcs = map[string]rpcAttributes{
+	"/kim.services.images.v1alpha1.Images/Build":        {"images", "build"},
+	"/kim.services.images.v1alpha1.Images/BuildSession": {"images", "build"},
+	"/kim.services.images.v1alpha1.Images/BuildStatus":  {"images", "watch"},
+	"/kim.services.images.v1alpha1.Images/Status":       {"images", "get"},
+	"/kim.services.images.v1alpha1.Images/Inspect":      {"images", "get"},
+	"/kim.services.images.v1alpha1.Images/History":      {"images", "get"},
+	"/kim.services.images.v1alpha1.Images/List":         {"images", "list"},
+	"/kim.services.images.v1alpha1.Images/Pull":         {"images", "pull"},
+	"/kim.services.images.v1alpha1.Images/PullProgress": {"images", "watch"},
+	"/kim.services.images.v1alpha1.Images/Push":         {"images", "push"},
+	"/kim.services.images.v1alpha1.Images/PushProgress": {"images", "watch"},
+	"/kim.services.images.v1alpha1.Images/Remove":       {"images", "delete"},
+	"/kim.services.images.v1alpha1.Images/Prune":        {"images", "delete"},
+	"/kim.services.images.v1alpha1.Images/Tag":          {"images", "tag"},
+	"/kim.services.images.v1alpha1.Images/Export":       {"images", "export"},
+	"/kim.services.images.v1alpha1.Images/Import":       {"images", "import"},
+	"/kim.services.images.v1alpha1.Images/Info":         {"images", "get"},
+	"/kim.services.images.v1alpha1.Images/CacheUsage":   {"cache", "get"},
+	"/kim.services.images.v1alpha1.Images/CachePrune":   {"cache", "delete"},
+	"/kim.services.images.v1alpha1.Images/Workers":      {"images", "get"},
+	"/kim.services.images.v1alpha1.Jobs/List":           {"jobs", "list"},
+	"/kim.services.images.v1alpha1.Jobs/Get":            {"jobs", "get"},
+	"/kim.services.images.v1alpha1.Jobs/Watch":          {"jobs", "watch"},
+	"/kim.services.images.v1alpha1.Jobs/Cancel":         {"jobs", "delete"},
+}
+
+// Identity of a client, as of its bearer token or else the subject of its certificate.
+type Identity struct {
+	User   string
+	UID    string
+	Groups []string
+	Extra  map[string]authorizationv1.ExtraValue
+}
+
+func (i Identity) String() string {
+	if len(i.Groups) == 0 {
+		return i.User
+	}
+	return i.User + " (" + strings.Join(i.Groups, ", ") + ")"
+}
+
+// ReadOnly returns true if the identity may only invoke read-only RPCs.
+func (i Identity) ReadOnly() bool {
+	for _, group := range i.Groups {
+		if group == ReadOnlyGroup {
+			return true
+		}
+	}
+	return false
+}
+
+type identityKey struct{}
+
+// IdentityFrom returns the identity of the client of the RPC, if it has been authenticated.
+func IdentityFrom(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// authorizer identifies the client of each RPC, logs the RPC and refuses those that the client is not permitted. With a
+// Kubernetes client, bearer tokens are authenticated with TokenReviews and all RPCs are authorized with
+// SubjectAccessReviews, otherwise clients with read-only certificates are refused all but read-only RPCs.
+type authorizer struct {
+	k8s *client.Interface
+
+	mu       sync.Mutex
+	tokens   map[[sha256.Size]byte]cachedIdentity
+	accesses map[string]cachedAccess
+}
+
+type cachedIdentity struct {
+	identity Identity
+	expires  time.Time
+}
+
+type cachedAccess struct {
+	allowed bool
+	reason  string
+	expires time.Time
+}
+
+func newAuthorizer(k8s *client.Interface) *authorizer {
+	return &authorizer{
+		k8s:      k8s,
+		tokens:   map[[sha256.Size]byte]cachedIdentity{},
+		accesses: map[string]cachedAccess{},
+	}
+}
+
+func (a *authorizer) authorize(ctx context.Context, method string) (context.Context, error) {
+	attributes, ok := rpcs[method]
+	if !ok {
+		return ctx, status.Errorf(codes.PermissionDenied, "%s is not authorized", method)
+	}
+	identity, authenticated, err := a.authenticate(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if err = a.check(ctx, method, identity, authenticated, attributes); err != nil {
+		return ctx, err
+	}
+	if !authenticated {
+		return ctx, nil
+	}
+	if attributes.ReadOnly() {
+		logrus.Debugf("%s: %s", method, identity)
+	} else {
+		logrus.Infof("%s: %s", method, identity)
+	}
+	return context.WithValue(ctx, identityKey{}, identity), nil
+}
+
+// check whether the identity may perform the action, with a SubjectAccessReview with token auth, otherwise only
+// read-only actions are permitted to read-only identities.
+func (a *authorizer) check(ctx context.Context, action string, identity Identity, authenticated bool, attributes rpcAttributes) error {
+	switch {
+	case a.k8s != nil:
+		if !authenticated {
+			return status.Errorf(codes.Unauthenticated, "%s requires a bearer token or client certificate", action)
+		}
+		allowed, reason, err := a.review(ctx, identity, attributes)
+		if err != nil {
+			logrus.Errorf("%s: failed to authorize %s: %v", action, identity, err)
+			return status.Errorf(codes.Unavailable, "failed to authorize %s", action)
+		}
+		if !allowed {
+			logrus.Warnf("%s: denied %s: %s", action, identity, reason)
+			return status.Errorf(codes.PermissionDenied, "%s may not %s %s.%s", identity.User, attributes.verb, attributes.resource, RBACGroup)
+		}
+	case authenticated && identity.ReadOnly() && !attributes.ReadOnly():
+		logrus.Warnf("%s: denied %s", action, identity)
+		return status.Errorf(codes.PermissionDenied, "%s may not invoke %s", identity.User, action)
+	}
+	return nil
+}
+
+// User returns the name of the user that the client of the RPC has been authenticated as, if any.
+func (a *authorizer) User(ctx context.Context) string {
+	identity, _ := IdentityFrom(ctx)
+	return identity.User
+}
+
+// Authorize the client of the RPC, as identified when the RPC itself was authorized, to perform the verb on the
+// resource as well.
+func (a *authorizer) Authorize(ctx context.Context, resource, verb string) error {
+	identity, authenticated := IdentityFrom(ctx)
+	attributes := rpcAttributes{resource: resource, verb: verb}
+	if err := a.check(ctx, verb+" "+resource, identity, authenticated, attributes); err != nil {
+		return err
+	}
+	logrus.Infof("%s %s: %s", verb, resource, identity)
+	return nil
+}
+
+// authenticate the client by its bearer token, with token auth, or else its verified certificate. With token auth,
+// certificates are only trusted as kim users without groups, as whoever can read the kim CA could issue themselves
+// any subject.
+func (a *authorizer) authenticate(ctx context.Context) (Identity, bool, error) {
+	if a.k8s != nil {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			for _, value := range md.Get("authorization") {
+				if strings.HasPrefix(value, "Bearer ") {
+					return a.authenticateToken(ctx, strings.TrimPrefix(value, "Bearer "))
+				}
+			}
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return Identity{}, false, nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return Identity{}, false, nil
+	}
+	subject := tlsInfo.State.VerifiedChains[0][0].Subject
+	if a.k8s != nil {
+		return Identity{User: CertUserPrefix + subject.CommonName}, true, nil
+	}
+	return Identity{
+		User:   subject.CommonName,
+		Groups: subject.Organization,
+	}, true, nil
+}
+
+func (a *authorizer) authenticateToken(ctx context.Context, token string) (Identity, bool, error) {
+	key := sha256.Sum256([]byte(token))
+	a.mu.Lock()
+	cached, ok := a.tokens[key]
+	a.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.identity, true, nil
+	}
+	review, err := a.k8s.Authentication.TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		logrus.Errorf("failed to review token: %v", err)
+		return Identity{}, false, status.Error(codes.Unavailable, "failed to authenticate bearer token")
+	}
+	if !review.Status.Authenticated {
+		return Identity{}, false, status.Errorf(codes.Unauthenticated, "invalid bearer token: %s", review.Status.Error)
+	}
+	identity := Identity{
+		User:   review.Status.User.Username,
+		UID:    review.Status.User.UID,
+		Groups: review.Status.User.Groups,
+		Extra:  map[string]authorizationv1.ExtraValue{},
+	}
+	for k, v := range review.Status.User.Extra {
+		identity.Extra[k] = authorizationv1.ExtraValue(v)
+	}
+	a.mu.Lock()
+	a.tokens[key] = cachedIdentity{identity: identity, expires: time.Now().Add(tokenReviewTTL)}
+	a.mu.Unlock()
+	return identity, true, nil
+}
+
+// review whether the identity may perform the verb on the resource in the namespace of the builder.
+func (a *authorizer) review(ctx context.Context, identity Identity, attributes rpcAttributes) (bool, string, error) {
+	key := strings.Join([]string{identity.User, identity.UID, strings.Join(identity.Groups, ","), attributes.resource, attributes.verb}, "|")
+	a.mu.Lock()
+	cached, ok := a.accesses[key]
+	a.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.allowed, cached.reason, nil
+	}
+	review, err := a.k8s.Authorization.SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: a.k8s.Namespace,
+				Group:     RBACGroup,
+				Resource:  attributes.resource,
+				Verb:      attributes.verb,
+			},
+			User:   identity.User,
+			UID:    identity.UID,
+			Groups: identity.Groups,
+			Extra:  identity.Extra,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, "", err
+	}
+	a.mu.Lock()
+	a.accesses[key] = cachedAccess{allowed: review.Status.Allowed, reason: review.Status.Reason, expires: time.Now().Add(accessReviewTTL)}
+	a.mu.Unlock()
+	return review.Status.Allowed, review.Status.Reason, nil
+}
+
+func (a *authorizer) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authorizer) stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authorizedStream carries the identity of the client in its context
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
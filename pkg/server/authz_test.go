@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"reflect"
+	"testing"
+
+	"github.com/rancher/kim/pkg/client"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestAuthenticateCertificate(t *testing.T) {
+	crt := &x509.Certificate{Subject: pkix.Name{CommonName: "alice", Organization: []string{"system:masters", ReadOnlyGroup}}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{crt}}}},
+	})
+	for _, tc := range []struct {
+		name     string
+		k8s      *client.Interface
+		identity Identity
+	}{
+		{"certificate auth", nil, Identity{User: "alice", Groups: []string{"system:masters", ReadOnlyGroup}}},
+		{"token auth", &client.Interface{}, Identity{User: CertUserPrefix + "alice"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			identity, authenticated, err := newAuthorizer(tc.k8s).authenticate(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !authenticated {
+				t.Fatal("not authenticated")
+			}
+			if !reflect.DeepEqual(identity, tc.identity) {
+				t.Errorf("authenticated as %#v, want %#v", identity, tc.identity)
+			}
+		})
+	}
+}
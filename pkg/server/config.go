@@ -21,6 +21,7 @@ const (
 	defaultAgentImage    = "docker.io/rancher/kim"
 	defaultBuildkitImage = "docker.io/moby/buildkit:v0.8.3"
 	buildkitNamespace    = "buildkit"
+	buildkitVolume       = "/var/lib/buildkit"
 
 	K3sContainerdSocket   = "/run/k3s/containerd/containerd.sock"
 	K3sContainerdVolume   = "/var/lib/rancher"
@@ -38,7 +39,6 @@ type Config struct {
 	AgentImage       string `usage:"Image to run the agent w/ missing tag inferred from version"`
 	AgentPort        int    `usage:"Port that the agent will listen on" default:"1233"`
 	BuildkitImage    string `usage:"BuildKit image for running buildkitd" default:"docker.io/moby/buildkit:v0.8.3"`
-	BuildkitPort     int    `usage:"BuildKit service port" default:"1234"`
 	BuildkitSocket   string `usage:"BuildKit socket address" default:"unix:///run/buildkit/buildkitd.sock"`
 	ContainerdSocket string `usage:"Containerd socket address (default on k3s \"/run/k3s/containerd/containerd.sock\")"`
 	ContainerdVolume string `usage:"Containerd storage volume (default on k3s \"/var/lib/rancher\")"`
@@ -79,13 +79,23 @@ func (c *Config) Interface(ctx context.Context, config *client.Config) (*images.
 		return nil, err
 	}
 	server := images.Server{
-		Kubernetes: k8s,
+		Kubernetes:       k8s,
+		BuildkitSocket:   c.BuildkitSocket,
+		BuildkitVolume:   buildkitVolume,
+		ContainerdSocket: c.ContainerdSocket,
+		ContainerdVolume: c.ContainerdVolume,
+		Context:          ctx,
 	}
 
 	server.Buildkit, err = buildkit.New(ctx, c.BuildkitSocket)
 	if err != nil {
 		return nil, err
 	}
+	server.BuildkitConn, err = grpc.DialContext(ctx, c.BuildkitSocket, grpc.WithInsecure())
+	if err != nil {
+		server.Close()
+		return nil, err
+	}
 	conn, err := grpc.DialContext(ctx, fmt.Sprintf("unix://%s", c.ContainerdSocket), grpc.WithInsecure(), grpc.WithBlock(), grpc.FailOnNonTempDialError(true))
 	if err != nil {
 		server.Close()
@@ -0,0 +1,177 @@
+package images
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+
+	controlapi "github.com/moby/buildkit/api/services/control"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/metadata"
+)
+
+// buildkit session headers are all prefixed with this, see github.com/moby/buildkit/session
+const sessionHeaderPrefix = "x-docker-expose-session-"
+
+// Build server-side impl
+func (s *Server) Build(ctx context.Context, req *imagesv1.ImageBuildRequest) (_ *imagesv1.ImageBuildResponse, err error) {
+	logrus.Infof("image-build: ref=%s, frontend=%s, exporter=%s, attrs=%v", req.Ref, req.Frontend, req.Exporter, req.ExporterAttrs)
+	if req.Squash && exportsPush(req.Exporter, req.ExporterAttrs) {
+		return nil, errdefs.ToGRPC(errors.Wrap(errdefs.ErrInvalidArgument, "squash cannot be combined with pushing the image"))
+	}
+	// the build is authorized as such, pushing its image or cache to a registry must be authorized as a push as well
+	if exportsPush(req.Exporter, req.ExporterAttrs) || cacheExportsPush(req.Cache) {
+		if err = s.authorize(ctx, "images", "push"); err != nil {
+			return nil, err
+		}
+	}
+	start := time.Now()
+	ctx, j, err := s.startJob(ctx, jobBuild, req.Ref, req.ExporterAttrs["name"], false)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		j.finish(err)
+	}()
+	req.Ref = j.info.Id
+	res, err := s.ControlService().Solve(ctx, &controlapi.SolveRequest{
+		Ref:            req.Ref,
+		Definition:     req.Definition,
+		Exporter:       req.Exporter,
+		ExporterAttrs:  req.ExporterAttrs,
+		Session:        req.Session,
+		Frontend:       req.Frontend,
+		FrontendAttrs:  req.FrontendAttrs,
+		Cache:          req.Cache,
+		Entitlements:   req.Entitlements,
+		FrontendInputs: req.FrontendInputs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.Squash {
+		var names []string
+		if name := res.ExporterResponse["image.name"]; name != "" {
+			names = strings.Split(name, ",")
+		}
+		if len(names) == 0 {
+			return nil, errdefs.ToGRPC(errors.Wrap(errdefs.ErrInvalidArgument, "squash requires a tagged image"))
+		}
+		if err = s.squash(ctx, names, start, res.ExporterResponse); err != nil {
+			return nil, err
+		}
+	}
+	return &imagesv1.ImageBuildResponse{
+		ExporterResponse: res.ExporterResponse,
+	}, nil
+}
+
+// exportsPush returns true if the exporter pushes the image to a registry as part of the solve.
+func exportsPush(exporter string, attrs map[string]string) bool {
+	switch exporter {
+	case "registry":
+		return true
+	case "image":
+		push, _ := strconv.ParseBool(attrs["push"])
+		return push
+	}
+	return false
+}
+
+// cacheExportsPush returns true if the build cache is exported anywhere but to the client or inline with the image.
+func cacheExportsPush(cache controlapi.CacheOptions) bool {
+	if cache.ExportRefDeprecated != "" {
+		return true
+	}
+	for _, export := range cache.Exports {
+		switch export.Type {
+		case "local", "inline":
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// BuildStatus server-side impl
+func (s *Server) BuildStatus(req *imagesv1.ImageBuildStatusRequest, srv imagesv1.Images_BuildStatusServer) error {
+	logrus.Debugf("image-build-status: %#v", req)
+	stream, err := s.ControlService().Status(srv.Context(), &controlapi.StatusRequest{Ref: req.Ref})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = srv.Send(&imagesv1.ImageBuildStatusResponse{
+			Vertexes: res.Vertexes,
+			Statuses: res.Statuses,
+			Logs:     res.Logs,
+		})
+		if err != nil {
+			logrus.Debugf("image-build-status-send-error: %v", err)
+			return err
+		}
+	}
+}
+
+// BuildSession server-side impl, shuttles the hijacked session connection between the client and buildkit
+func (s *Server) BuildSession(srv imagesv1.Images_BuildSessionServer) error {
+	md := metadata.MD{}
+	if in, ok := metadata.FromIncomingContext(srv.Context()); ok {
+		for k, v := range in {
+			if strings.HasPrefix(k, sessionHeaderPrefix) {
+				md[k] = v
+			}
+		}
+	}
+	logrus.Debugf("image-build-session: %v", md)
+	eg, ctx := errgroup.WithContext(metadata.NewOutgoingContext(srv.Context(), md))
+	session, err := s.ControlService().Session(ctx)
+	if err != nil {
+		return err
+	}
+	// client -> buildkit
+	eg.Go(func() error {
+		for {
+			msg, err := srv.Recv()
+			if err == io.EOF {
+				return session.CloseSend()
+			}
+			if err != nil {
+				return err
+			}
+			if err = session.Send(msg); err != nil {
+				return err
+			}
+		}
+	})
+	// buildkit -> client
+	eg.Go(func() error {
+		for {
+			msg, err := session.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err = srv.Send(msg); err != nil {
+				return err
+			}
+		}
+	})
+	return eg.Wait()
+}
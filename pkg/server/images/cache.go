@@ -0,0 +1,42 @@
+package images
+
+import (
+	"context"
+	"io"
+
+	controlapi "github.com/moby/buildkit/api/services/control"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// CacheUsage server-side impl, proxied to buildkitd over its local socket
+func (s *Server) CacheUsage(ctx context.Context, req *controlapi.DiskUsageRequest) (*controlapi.DiskUsageResponse, error) {
+	logrus.Debugf("cache-usage: %#v", req)
+	return s.ControlService().DiskUsage(ctx, req)
+}
+
+// CachePrune server-side impl, proxied to buildkitd over its local socket
+func (s *Server) CachePrune(req *controlapi.PruneRequest, srv imagesv1.Images_CachePruneServer) error {
+	logrus.Debugf("cache-prune: %#v", req)
+	stream, err := s.ControlService().Prune(srv.Context(), req)
+	if err != nil {
+		return err
+	}
+	for {
+		record, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = srv.Send(record); err != nil {
+			return err
+		}
+	}
+}
+
+// Workers server-side impl, proxied to buildkitd over its local socket
+func (s *Server) Workers(ctx context.Context, req *controlapi.ListWorkersRequest) (*controlapi.ListWorkersResponse, error) {
+	return s.ControlService().ListWorkers(ctx, req)
+}
@@ -0,0 +1,59 @@
+package images
+
+import (
+	"bufio"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images/archive"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/platforms"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// chunks written to the export stream are at most this large, well under the default grpc message size limit
+const transferChunkSize = 1 << 20
+
+// Export server-side impl, adapted from containerd's `ctr export` implementation
+func (s *Server) Export(req *imagesv1.ImageExportRequest, srv imagesv1.Images_ExportServer) error {
+	logrus.Debugf("image-export: %#v", req)
+	ctx := namespaces.WithNamespace(srv.Context(), "k8s.io")
+
+	var opts []archive.ExportOpt
+	switch {
+	case req.AllPlatforms:
+		opts = append(opts, archive.WithAllPlatforms())
+	case req.Platform != "":
+		platform, err := platforms.Parse(req.Platform)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, archive.WithPlatform(platforms.Only(platform)))
+	default:
+		opts = append(opts, archive.WithPlatform(platforms.Default()))
+	}
+	is := s.Containerd.ImageService()
+	for _, image := range req.Images {
+		if _, err := is.Get(ctx, image.Image); err != nil {
+			return errdefs.ToGRPC(err)
+		}
+		opts = append(opts, archive.WithImage(is, image.Image))
+	}
+
+	w := bufio.NewWriterSize(&exportWriter{srv: srv}, transferChunkSize)
+	if err := s.Containerd.Export(ctx, w, opts...); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+type exportWriter struct {
+	srv imagesv1.Images_ExportServer
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if err := w.srv.Send(&imagesv1.ImageExportResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
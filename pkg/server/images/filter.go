@@ -0,0 +1,282 @@
+package images
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/docker/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+)
+
+// imageFilters are docker-style image filters, values for the same key match if any do, except for labels which must
+// all match, while all keys must match.
+type imageFilters map[string][]string
+
+var validImageFilters = map[string]bool{
+	"before":    true,
+	"dangling":  true,
+	"label":     true,
+	"reference": true,
+	"since":     true,
+	"until":     true,
+}
+
+// parseImageFilters parses key=value filter strings, such as those passed via `kim images --filter`.
+func parseImageFilters(filters []string) (imageFilters, error) {
+	f := imageFilters{}
+	for _, filter := range filters {
+		kv := strings.SplitN(filter, "=", 2)
+		if len(kv) != 2 {
+			return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrInvalidArgument, "bad format of filter %q (expected name=value)", filter))
+		}
+		key, value := strings.ToLower(strings.TrimSpace(kv[0])), strings.TrimSpace(kv[1])
+		if !validImageFilters[key] {
+			return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrInvalidArgument, "invalid filter %q", key))
+		}
+		switch key {
+		case "dangling":
+			if _, err := strconv.ParseBool(value); err != nil {
+				return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrInvalidArgument, "invalid filter 'dangling=%s'", value))
+			}
+		case "until":
+			if _, err := parseTimestamp(value, time.Now()); err != nil {
+				return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrInvalidArgument, "invalid filter 'until=%s': %v", value, err))
+			}
+		}
+		f[key] = append(f[key], value)
+	}
+	return f, nil
+}
+
+// parseTimestamp parses a duration relative to now, an RFC3339 timestamp or a unix timestamp in seconds.
+func parseTimestamp(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	if sec, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.Unix(0, int64(sec*float64(time.Second))), nil
+	}
+	return time.Time{}, errors.Errorf("cannot parse %q as a duration or timestamp", value)
+}
+
+// imageMetadata reads the created timestamp and labels from the config of the image, keyed by the image id which
+// is the digest of said config.
+func (s *Server) imageMetadata(ctx context.Context, image *criv1.Image) *imagesv1.ImageMetadata {
+	meta := &imagesv1.ImageMetadata{}
+	dgst, err := digest.Parse(image.Id)
+	if err != nil {
+		logrus.Debugf("image-metadata: %s: %v", image.Id, err)
+		return meta
+	}
+	p, err := content.ReadBlob(ctx, s.Containerd.ContentStore(), ocispec.Descriptor{Digest: dgst})
+	if err != nil {
+		logrus.Debugf("image-metadata: %s: %v", image.Id, err)
+		return meta
+	}
+	var config ocispec.Image
+	if err = json.Unmarshal(p, &config); err != nil {
+		logrus.Debugf("image-metadata: %s: %v", image.Id, err)
+		return meta
+	}
+	if config.Created != nil {
+		meta.Created = *config.Created
+	}
+	meta.Labels = config.Config.Labels
+	meta.Platforms = s.imagePlatforms(ctx, image)
+	return meta
+}
+
+// imagePlatforms returns the platforms of the image whose manifests are present in the content store.
+func (s *Server) imagePlatforms(ctx context.Context, image *criv1.Image) []string {
+	refs := append([]string{image.Id}, image.RepoTags...)
+	refs = append(refs, image.RepoDigests...)
+	for _, ref := range refs {
+		img, err := s.Containerd.ImageService().Get(ctx, ref)
+		if err != nil {
+			continue
+		}
+		store := s.Containerd.ContentStore()
+		var result []string
+		handler := images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+			if _, err := store.Info(ctx, desc.Digest); err != nil {
+				return nil, images.ErrSkipDesc // not pulled
+			}
+			switch {
+			case images.IsIndexType(desc.MediaType):
+				return images.Children(ctx, store, desc)
+			case images.IsManifestType(desc.MediaType):
+				if desc.Platform != nil {
+					result = append(result, platforms.Format(*desc.Platform))
+				} else if p, err := manifestPlatform(ctx, store, desc); err == nil {
+					result = append(result, p)
+				}
+			}
+			return nil, nil
+		})
+		if err := images.Walk(ctx, handler, img.Target); err != nil {
+			logrus.Debugf("image-platforms: %s: %v", ref, err)
+		}
+		return result
+	}
+	return nil
+}
+
+// manifestPlatform returns the platform of a manifest not referenced by an index, from its config.
+func manifestPlatform(ctx context.Context, store content.Store, desc ocispec.Descriptor) (string, error) {
+	p, err := content.ReadBlob(ctx, store, desc)
+	if err != nil {
+		return "", err
+	}
+	var manifest ocispec.Manifest
+	if err = json.Unmarshal(p, &manifest); err != nil {
+		return "", err
+	}
+	if p, err = content.ReadBlob(ctx, store, manifest.Config); err != nil {
+		return "", err
+	}
+	var config ocispec.Image
+	if err = json.Unmarshal(p, &config); err != nil {
+		return "", err
+	}
+	return platforms.Format(ocispec.Platform{OS: config.OS, Architecture: config.Architecture}), nil
+}
+
+// filterImages returns the images matching both the filters and any of the references (when provided).
+func filterImages(images []*criv1.Image, metadata map[string]*imagesv1.ImageMetadata, filters imageFilters, references []string) ([]*criv1.Image, error) {
+	now := time.Now()
+	var before, since []time.Time
+	for _, key := range []string{"before", "since"} {
+		for _, value := range filters[key] {
+			image := findImage(images, value)
+			if image == nil {
+				return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrNotFound, "image %q", value))
+			}
+			created := metadata[image.Id].Created
+			if key == "before" {
+				before = append(before, created)
+			} else {
+				since = append(since, created)
+			}
+		}
+	}
+	var until []time.Time
+	for _, value := range filters["until"] {
+		t, err := parseTimestamp(value, now)
+		if err != nil {
+			return nil, err
+		}
+		until = append(until, t)
+	}
+
+	var result []*criv1.Image
+	for _, image := range images {
+		meta := metadata[image.Id]
+		switch {
+		case len(references) > 0 && !matchReference(image, references):
+		case len(filters["reference"]) > 0 && !matchReference(image, filters["reference"]):
+		case len(filters["dangling"]) > 0 && !matchDangling(image, filters["dangling"]):
+		case len(filters["label"]) > 0 && !matchLabel(meta.Labels, filters["label"]):
+		case len(before) > 0 && !anyTime(before, meta.Created.Before):
+		case len(since) > 0 && !anyTime(since, meta.Created.After):
+		case len(until) > 0 && !anyTime(until, meta.Created.Before):
+		default:
+			result = append(result, image)
+		}
+	}
+	return result, nil
+}
+
+// findImage finds the image by id (or unique prefix thereof) or by tag.
+func findImage(images []*criv1.Image, name string) *criv1.Image {
+	if named, err := reference.ParseNormalizedNamed(name); err == nil {
+		tagged := reference.TagNameOnly(named).String()
+		for _, image := range images {
+			for _, tag := range image.RepoTags {
+				if tag == tagged {
+					return image
+				}
+			}
+		}
+	}
+	id := strings.TrimPrefix(name, "sha256:")
+	var found *criv1.Image
+	for _, image := range images {
+		if strings.HasPrefix(strings.TrimPrefix(image.Id, "sha256:"), id) {
+			if found != nil {
+				return nil // ambiguous
+			}
+			found = image
+		}
+	}
+	return found
+}
+
+func matchReference(image *criv1.Image, patterns []string) bool {
+	for _, tag := range image.RepoTags {
+		named, err := reference.ParseNormalizedNamed(tag)
+		if err != nil {
+			continue
+		}
+		for _, pattern := range patterns {
+			if ok, _ := reference.FamiliarMatch(pattern, named); ok {
+				return true
+			}
+			// also match fully-qualified patterns, e.g. docker.io/library/*, which FamiliarMatch does not
+			if ok, _ := path.Match(pattern, named.String()); ok {
+				return true
+			}
+			if ok, _ := path.Match(pattern, named.Name()); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchDangling(image *criv1.Image, values []string) bool {
+	for _, value := range values {
+		dangling, _ := strconv.ParseBool(value)
+		if dangling == (len(image.RepoTags) == 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchLabel returns true if the labels match all of the values, each a label key or key=value.
+func matchLabel(labels map[string]string, values []string) bool {
+	for _, value := range values {
+		kv := strings.SplitN(value, "=", 2)
+		if v, ok := labels[kv[0]]; !ok || (len(kv) == 2 && v != kv[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func anyTime(times []time.Time, fn func(time.Time) bool) bool {
+	for _, t := range times {
+		if fn(t) {
+			return true
+		}
+	}
+	return false
+}
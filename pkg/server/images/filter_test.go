@@ -0,0 +1,56 @@
+package images
+
+import (
+	"testing"
+
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+)
+
+func TestMatchLabel(t *testing.T) {
+	labels := map[string]string{
+		"maintainer": "kim",
+		"version":    "1.0",
+	}
+	for _, tc := range []struct {
+		name   string
+		values []string
+		match  bool
+	}{
+		{"key", []string{"maintainer"}, true},
+		{"key and value", []string{"maintainer=kim"}, true},
+		{"other value", []string{"maintainer=k3s"}, false},
+		{"missing key", []string{"vendor"}, false},
+		{"two labels", []string{"maintainer=kim", "version=1.0"}, true},
+		{"two labels, one not matching", []string{"maintainer=kim", "version=2.0"}, false},
+		{"two labels, one missing", []string{"maintainer", "vendor"}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if match := matchLabel(labels, tc.values); match != tc.match {
+				t.Errorf("matchLabel(%v) = %v, want %v", tc.values, match, tc.match)
+			}
+		})
+	}
+}
+
+func TestMatchReference(t *testing.T) {
+	image := &criv1.Image{RepoTags: []string{"docker.io/library/busybox:latest", "docker.io/rancher/kim:v0.1.0"}}
+	for _, tc := range []struct {
+		pattern string
+		match   bool
+	}{
+		{"busybox", true},
+		{"busybox:latest", true},
+		{"busybox:1.32", false},
+		{"rancher/*", true},
+		{"rancher/kim:v0.*", true},
+		{"docker.io/library/*", true},
+		{"docker.io/library/busybox:latest", true},
+		{"docker.io/rancher/*:v0.1.0", true},
+		{"quay.io/library/*", false},
+		{"alpine", false},
+	} {
+		if match := matchReference(image, []string{tc.pattern}); match != tc.match {
+			t.Errorf("matchReference(%q) = %v, want %v", tc.pattern, match, tc.match)
+		}
+	}
+}
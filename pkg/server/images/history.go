@@ -0,0 +1,88 @@
+package images
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// History of an image server-side impl, joins the config history with the manifest layers
+func (s *Server) History(ctx context.Context, req *imagesv1.ImageHistoryRequest) (*imagesv1.ImageHistoryResponse, error) {
+	logrus.Debugf("image-history: %#v", req)
+	ctx = namespaces.WithNamespace(ctx, "k8s.io")
+	img, err := s.Containerd.ImageService().Get(ctx, req.Image.Image)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	platform := platforms.Default()
+	if req.Platform != "" {
+		p, err := platforms.Parse(req.Platform)
+		if err != nil {
+			return nil, err
+		}
+		platform = platforms.Only(p)
+	}
+	store := s.Containerd.ContentStore()
+	desc, err := resolveManifest(ctx, store, img.Target, platform, &imagesv1.ImageInspectResponse{})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		manifest ocispec.Manifest
+		config   ocispec.Image
+	)
+	p, err := content.ReadBlob(ctx, store, desc)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(p, &manifest); err != nil {
+		return nil, err
+	}
+	if p, err = content.ReadBlob(ctx, store, manifest.Config); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(p, &config); err != nil {
+		return nil, err
+	}
+
+	res := &imagesv1.ImageHistoryResponse{
+		Id: manifest.Config.Digest.String(),
+	}
+	layers := manifest.Layers
+	for _, h := range config.History {
+		entry := imagesv1.ImageHistory{
+			CreatedBy:  h.CreatedBy,
+			Author:     h.Author,
+			Comment:    h.Comment,
+			EmptyLayer: h.EmptyLayer,
+		}
+		if h.Created != nil {
+			entry.Created = *h.Created
+		}
+		if !h.EmptyLayer && len(layers) > 0 {
+			entry.Layer = layers[0].Digest.String()
+			entry.Size_ = layers[0].Size
+			layers = layers[1:]
+		}
+		res.History = append(res.History, entry)
+	}
+	// layers without history, e.g. images assembled by tools that do not record it
+	for _, layer := range layers {
+		res.History = append(res.History, imagesv1.ImageHistory{
+			Layer: layer.Digest.String(),
+			Size_: layer.Size,
+		})
+	}
+	// most recent first
+	for i, j := 0, len(res.History)-1; i < j; i, j = i+1, j-1 {
+		res.History[i], res.History[j] = res.History[j], res.History[i]
+	}
+	return res, nil
+}
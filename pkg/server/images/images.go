@@ -1,6 +1,7 @@
 package images
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"sync"
@@ -8,26 +9,51 @@ import (
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/remotes"
 	"github.com/containerd/containerd/remotes/docker"
+	controlapi "github.com/moby/buildkit/api/services/control"
 	buildkit "github.com/moby/buildkit/client"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
 	"github.com/rancher/kim/pkg/auth"
 	"github.com/rancher/kim/pkg/client"
+	"github.com/rancher/kim/pkg/registries"
 	"github.com/rancher/kim/pkg/version"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
 )
 
 var _ imagesv1.ImagesServer = &Server{}
 
+// Authorizer identifies and authorizes the client of an RPC.
+type Authorizer interface {
+	// User returns the name of the user that the client is authenticated as, if any.
+	User(ctx context.Context) string
+	// Authorize the client to perform the verb on the resource.
+	Authorize(ctx context.Context, resource, verb string) error
+}
+
 type Server struct {
-	Kubernetes *client.Interface
-	Buildkit   *buildkit.Client
-	Containerd *containerd.Client
+	Kubernetes   *client.Interface
+	Buildkit     *buildkit.Client
+	BuildkitConn *grpc.ClientConn
+	Containerd   *containerd.Client
+	Registries   string // path to the registries config, see Resolver
+	// sockets and volumes of the backends, as reported by Info
+	BuildkitSocket   string
+	BuildkitVolume   string
+	ContainerdSocket string
+	ContainerdVolume string
+	// Context of the agent, which pulls and pushes run on so that they outlive the requests that started them
+	Context context.Context
+	// Authorizer, if any, authorizes actions beyond those that the RPCs themselves are authorized as
+	Authorizer Authorizer
 
 	criImages criv1.ImageServiceClient
 	criOnce   sync.Once
 
-	pushJobs sync.Map
+	control     controlapi.ControlClient
+	controlOnce sync.Once
+
+	jobs jobs
 }
 
 func (s *Server) ImageService() criv1.ImageServiceClient {
@@ -37,6 +63,30 @@ func (s *Server) ImageService() criv1.ImageServiceClient {
 	return s.criImages
 }
 
+// ControlService returns the buildkit control api client, the buildkit client does not expose its own.
+func (s *Server) ControlService() controlapi.ControlClient {
+	s.controlOnce.Do(func() {
+		s.control = controlapi.NewControlClient(s.BuildkitConn)
+	})
+	return s.control
+}
+
+// user returns the name of the user that the client of the RPC is authenticated as, if any.
+func (s *Server) user(ctx context.Context) string {
+	if s.Authorizer == nil {
+		return ""
+	}
+	return s.Authorizer.User(ctx)
+}
+
+// authorize the client of the RPC to perform the verb on the resource, if the server has an Authorizer.
+func (s *Server) authorize(ctx context.Context, resource, verb string) error {
+	if s.Authorizer == nil {
+		return nil
+	}
+	return s.Authorizer.Authorize(ctx, resource, verb)
+}
+
 // Close the Server connections to various backends.
 func (s *Server) Close() {
 	if s.Buildkit != nil {
@@ -44,6 +94,11 @@ func (s *Server) Close() {
 			logrus.Warnf("error closing connection to buildkit: %v", err)
 		}
 	}
+	if s.BuildkitConn != nil {
+		if err := s.BuildkitConn.Close(); err != nil {
+			logrus.Warnf("error closing connection to buildkit control: %v", err)
+		}
+	}
 	if s.Containerd != nil {
 		// this will close the underlying grpc connection making the cri runtime/images clients inoperable as well
 		if err := s.Containerd.Close(); err != nil {
@@ -52,21 +107,35 @@ func (s *Server) Close() {
 	}
 }
 
-func Resolver(authConfig *criv1.AuthConfig, statusTracker docker.StatusTracker) remotes.Resolver {
-	authorizer := docker.NewDockerAuthorizer(
-		docker.WithAuthClient(http.DefaultClient),
-		docker.WithAuthCreds(func(host string) (string, string, error) {
-			return auth.Parse(authConfig, host)
-		}),
-		docker.WithAuthHeader(http.Header{
+// Resolver for pulls and pushes, with the mirrors, TLS and fallback credentials of the registries config which is
+// loaded every time so that changes are picked up without restarting the agent.
+func (s *Server) Resolver(authConfig *criv1.AuthConfig, statusTracker docker.StatusTracker) (remotes.Resolver, error) {
+	registry, err := registries.Load(s.Registries)
+	if err != nil {
+		return nil, err
+	}
+	credentials := func(host string) (auth.Credentials, error) {
+		credentials, err := auth.ParseCredentials(authConfig, host)
+		if err != nil || !credentials.Empty() {
+			return credentials, err
+		}
+		if config, ok := registry.Configs[host]; ok && config.Auth != nil {
+			return auth.ParseCredentials(&criv1.AuthConfig{
+				Username:      config.Auth.Username,
+				Password:      config.Auth.Password,
+				Auth:          config.Auth.Auth,
+				IdentityToken: config.Auth.IdentityToken,
+			}, host)
+		}
+		return credentials, nil
+	}
+	authorizer := func(client *http.Client) docker.Authorizer {
+		return auth.NewAuthorizer(client, http.Header{
 			"User-Agent": []string{fmt.Sprintf("rancher-kim/%s", version.Version)},
-		}),
-	)
+		}, credentials)
+	}
 	return docker.NewResolver(docker.ResolverOptions{
 		Tracker: statusTracker,
-		Hosts: docker.ConfigureDefaultRegistries(
-			docker.WithAuthorizer(authorizer),
-		),
-	})
-
+		Hosts:   registry.Hosts(authorizer),
+	}), nil
 }
@@ -0,0 +1,49 @@
+package images
+
+import (
+	"io"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/namespaces"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// Import server-side impl, adapted from containerd's `ctr import` implementation
+func (s *Server) Import(srv imagesv1.Images_ImportServer) error {
+	ctx := namespaces.WithNamespace(srv.Context(), "k8s.io")
+
+	pr, pw := io.Pipe()
+	go func() {
+		for {
+			req, err := srv.Recv()
+			if err == io.EOF {
+				pw.Close()
+				return
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			if _, err = pw.Write(req.Data); err != nil {
+				return
+			}
+		}
+	}()
+
+	imgs, err := s.Containerd.Import(ctx, pr)
+	pr.CloseWithError(err)
+	if err != nil {
+		return err
+	}
+
+	res := &imagesv1.ImageImportResponse{}
+	for _, img := range imgs {
+		logrus.Debugf("image-import: unpacking %s (%s)", img.Name, img.Target.Digest)
+		if err = containerd.NewImage(s.Containerd, img).Unpack(ctx, ""); err != nil {
+			return err
+		}
+		res.Images = append(res.Images, img.Name)
+	}
+	return srv.SendAndClose(res)
+}
@@ -0,0 +1,44 @@
+package images
+
+import (
+	"context"
+	"runtime"
+
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/version"
+	"github.com/sirupsen/logrus"
+)
+
+// Info server-side impl, reporting the versions, sockets and volume usage of the agent and its backends
+func (s *Server) Info(ctx context.Context, _ *imagesv1.InfoRequest) (*imagesv1.InfoResponse, error) {
+	res := &imagesv1.InfoResponse{
+		Version:          version.Version,
+		GitCommit:        version.GitCommit,
+		Platform:         runtime.GOOS + "/" + runtime.GOARCH,
+		ContainerdSocket: s.ContainerdSocket,
+		BuildkitSocket:   s.BuildkitSocket,
+	}
+	ver, err := s.Containerd.Version(ctx)
+	if err != nil {
+		logrus.Warnf("failed to get containerd version: %v", err)
+	} else {
+		res.ContainerdVersion = ver.Version
+	}
+	for _, volume := range []struct{ name, path string }{
+		{"buildkit", s.BuildkitVolume},
+		{"containerd", s.ContainerdVolume},
+	} {
+		if volume.path == "" {
+			continue
+		}
+		usage := &imagesv1.VolumeUsage{
+			Name: volume.name,
+			Path: volume.path,
+		}
+		if err := volumeUsage(volume.path, usage); err != nil {
+			usage.Error = err.Error()
+		}
+		res.Volumes = append(res.Volumes, usage)
+	}
+	return res, nil
+}
@@ -0,0 +1,17 @@
+package images
+
+import (
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"golang.org/x/sys/unix"
+)
+
+func volumeUsage(path string, usage *imagesv1.VolumeUsage) error {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		return err
+	}
+	usage.Total = st.Blocks * uint64(st.Bsize)
+	usage.Available = st.Bavail * uint64(st.Bsize)
+	usage.Used = (st.Blocks - st.Bfree) * uint64(st.Bsize)
+	return nil
+}
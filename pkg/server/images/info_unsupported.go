@@ -0,0 +1,12 @@
+// +build !linux
+
+package images
+
+import (
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+)
+
+func volumeUsage(_ string, _ *imagesv1.VolumeUsage) error {
+	return errors.New("volume usage is not supported on this platform")
+}
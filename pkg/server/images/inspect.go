@@ -0,0 +1,102 @@
+package images
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+)
+
+// Inspect image server-side impl
+func (s *Server) Inspect(ctx context.Context, req *imagesv1.ImageInspectRequest) (*imagesv1.ImageInspectResponse, error) {
+	logrus.Debugf("image-inspect: %#v", req)
+	ctx = namespaces.WithNamespace(ctx, "k8s.io")
+	img, err := s.Containerd.ImageService().Get(ctx, req.Image.Image)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	platform := platforms.Default()
+	if req.Platform != "" {
+		p, err := platforms.Parse(req.Platform)
+		if err != nil {
+			return nil, err
+		}
+		platform = platforms.Only(p)
+	}
+	res := &imagesv1.ImageInspectResponse{
+		Name:      img.Name,
+		MediaType: img.Target.MediaType,
+		Digest:    img.Target.Digest.String(),
+	}
+	isr, err := s.ImageService().ImageStatus(ctx, &criv1.ImageStatusRequest{Image: req.Image})
+	if err != nil {
+		return nil, err
+	}
+	res.Image = isr.Image
+
+	store := s.Containerd.ContentStore()
+	manifest, err := resolveManifest(ctx, store, img.Target, platform, res)
+	if err != nil {
+		return nil, err
+	}
+	if res.Manifest, err = content.ReadBlob(ctx, store, manifest); err != nil {
+		return nil, err
+	}
+	var m ocispec.Manifest
+	if err = json.Unmarshal(res.Manifest, &m); err != nil {
+		return nil, err
+	}
+	if res.Config, err = content.ReadBlob(ctx, store, m.Config); err != nil {
+		return nil, err
+	}
+	ps, err := images.Platforms(ctx, store, img.Target)
+	if err != nil {
+		logrus.Debugf("image-inspect: failed to determine platforms for %s: %v", img.Name, err)
+	}
+	for _, p := range ps {
+		res.Platforms = append(res.Platforms, platforms.Format(p))
+	}
+	return res, nil
+}
+
+// resolveManifest returns the descriptor of the manifest for the platform, recording the raw index when the target
+// is one.
+func resolveManifest(ctx context.Context, store content.Store, target ocispec.Descriptor, platform platforms.MatchComparer, res *imagesv1.ImageInspectResponse) (ocispec.Descriptor, error) {
+	switch {
+	case images.IsManifestType(target.MediaType):
+		return target, nil
+	case images.IsIndexType(target.MediaType):
+		p, err := content.ReadBlob(ctx, store, target)
+		if err != nil {
+			return ocispec.Descriptor{}, err
+		}
+		if res.Index == nil {
+			res.Index = p
+		}
+		var idx ocispec.Index
+		if err := json.Unmarshal(p, &idx); err != nil {
+			return ocispec.Descriptor{}, err
+		}
+		for _, desc := range idx.Manifests {
+			if desc.Platform != nil && !platform.Match(*desc.Platform) {
+				continue
+			}
+			if _, err := store.Info(ctx, desc.Digest); errdefs.IsNotFound(err) {
+				continue // not pulled
+			}
+			return resolveManifest(ctx, store, desc, platform, res)
+		}
+		return ocispec.Descriptor{}, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrNotFound, "manifest for %s", target.Digest))
+	default:
+		return ocispec.Descriptor{}, errors.Errorf("unexpected media type %v for %v", target.MediaType, target.Digest)
+	}
+}
@@ -0,0 +1,360 @@
+package images
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	controlapi "github.com/moby/buildkit/api/services/control"
+	"github.com/moby/buildkit/identity"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	jobBuild = "build"
+	jobPull  = "pull"
+	jobPush  = "push"
+
+	jobRunning   = "running"
+	jobCompleted = "completed"
+	jobFailed    = "failed"
+	jobCancelled = "cancelled"
+
+	// how long finished jobs are kept around for, so that their outcome can be retrieved
+	jobRetention = 10 * time.Minute
+)
+
+// job is a long-running operation, a build, pull or push, that may be watched or cancelled by id.
+type job struct {
+	info      imagesv1.Job
+	progress  []imagesv1.ImageStatus
+	cancel    context.CancelFunc
+	cancelled bool
+	tracked   chan struct{}
+	done      chan struct{}
+	mu        sync.Mutex
+}
+
+// snapshot returns a copy of the job info and its latest progress.
+func (j *job) snapshot() (*imagesv1.Job, []imagesv1.ImageStatus) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	info := j.info
+	return &info, append([]imagesv1.ImageStatus{}, j.progress...)
+}
+
+// track records the latest progress from the status channel until it is closed.
+func (j *job) track(statuses <-chan []imagesv1.ImageStatus) {
+	j.tracked = make(chan struct{})
+	go func() {
+		defer close(j.tracked)
+		for status := range statuses {
+			j.mu.Lock()
+			j.progress = status
+			j.mu.Unlock()
+		}
+	}()
+}
+
+// finish cancels the job context, waits for the final progress, and records the outcome.
+func (j *job) finish(err error) {
+	j.cancel()
+	if j.tracked != nil {
+		<-j.tracked
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := time.Now()
+	j.info.FinishedAt = &now
+	switch {
+	case err == nil:
+		j.info.State = jobCompleted
+	case j.cancelled:
+		j.info.State = jobCancelled
+		j.info.Error = err.Error()
+	default:
+		j.info.State = jobFailed
+		j.info.Error = err.Error()
+	}
+	close(j.done)
+}
+
+// jobs is the registry of running and recently finished jobs.
+type jobs struct {
+	jobs map[string]*job
+	mu   sync.Mutex
+}
+
+func (r *jobs) get(id string) (*job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if j, ok := r.jobs[id]; ok {
+		return j, nil
+	}
+	return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrNotFound, "job %q", id))
+}
+
+// wait returns the job once it has been registered, progress may be requested before the operation has started.
+func (r *jobs) wait(ctx context.Context, id string) (*job, error) {
+	for {
+		if j, err := r.get(id); err == nil {
+			return j, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// find returns the most recent running job of the kind for the image, for clients that do not send request ids.
+func (r *jobs) find(kind, image string) *job {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var (
+		found   *job
+		created time.Time
+	)
+	for _, j := range r.jobs {
+		info, _ := j.snapshot()
+		if info.Kind != kind || info.Image != image || info.State != jobRunning {
+			continue
+		}
+		if found == nil || info.CreatedAt.After(created) {
+			found, created = j, info.CreatedAt
+		}
+	}
+	return found
+}
+
+func (r *jobs) list() []*job {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := make([]*job, 0, len(r.jobs))
+	for _, j := range r.jobs {
+		list = append(list, j)
+	}
+	return list
+}
+
+// startJob registers a job for the operation, generating an id if none was requested, and owned by the client of
+// the RPC. The returned context is cancelled by Cancel, or once the job is finished. Detached jobs run on the context
+// of the agent, so that they carry on should the client go away, otherwise the context is that of the RPC.
+func (s *Server) startJob(ctx context.Context, kind, id, image string, detached bool) (context.Context, *job, error) {
+	if id == "" {
+		id = identity.NewID()
+	}
+	owner := s.user(ctx)
+	if detached {
+		ctx = s.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	j := &job{
+		info: imagesv1.Job{
+			Id:        id,
+			Kind:      kind,
+			Image:     image,
+			State:     jobRunning,
+			CreatedAt: time.Now(),
+			Owner:     owner,
+		},
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	s.jobs.mu.Lock()
+	defer s.jobs.mu.Unlock()
+	if s.jobs.jobs == nil {
+		s.jobs.jobs = map[string]*job{}
+	}
+	if _, exists := s.jobs.jobs[id]; exists {
+		cancel()
+		return nil, nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrAlreadyExists, "job %q", id))
+	}
+	s.jobs.jobs[id] = j
+	go func() {
+		<-j.done
+		time.AfterFunc(jobRetention, func() {
+			s.jobs.mu.Lock()
+			defer s.jobs.mu.Unlock()
+			delete(s.jobs.jobs, id)
+		})
+	}()
+	logrus.Debugf("job-start: kind=%s, id=%s, image=%s, owner=%s", kind, id, image, owner)
+	return ctx, j, nil
+}
+
+// Jobs returns the jobs service backed by this server.
+func (s *Server) Jobs() imagesv1.JobsServer {
+	return &jobsServer{Server: s}
+}
+
+type jobsServer struct {
+	*Server
+}
+
+// List jobs server-side impl
+func (s *jobsServer) List(_ context.Context, req *imagesv1.JobListRequest) (*imagesv1.JobListResponse, error) {
+	res := &imagesv1.JobListResponse{}
+	for _, j := range s.jobs.list() {
+		info, _ := j.snapshot()
+		if !req.All && info.State != jobRunning {
+			continue
+		}
+		res.Jobs = append(res.Jobs, info)
+	}
+	sort.Slice(res.Jobs, func(i, j int) bool {
+		return res.Jobs[i].CreatedAt.Before(res.Jobs[j].CreatedAt)
+	})
+	return res, nil
+}
+
+// Get job server-side impl
+func (s *jobsServer) Get(_ context.Context, req *imagesv1.JobGetRequest) (*imagesv1.JobGetResponse, error) {
+	j, err := s.jobs.get(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	info, _ := j.snapshot()
+	return &imagesv1.JobGetResponse{Job: info}, nil
+}
+
+// Watch job server-side impl
+func (s *jobsServer) Watch(req *imagesv1.JobWatchRequest, srv imagesv1.Jobs_WatchServer) error {
+	logrus.Debugf("job-watch: %#v", req)
+	j, err := s.jobs.get(req.Id)
+	if err != nil {
+		return err
+	}
+	info, _ := j.snapshot()
+	if info.Kind == jobBuild && info.State == jobRunning {
+		if err = s.watchBuild(j, srv); err != nil {
+			return err
+		}
+	}
+	return s.watchProgress(j, srv)
+}
+
+// watchProgress sends the latest progress of the job until it is finished, and then its outcome.
+func (s *jobsServer) watchProgress(j *job, srv imagesv1.Jobs_WatchServer) error {
+	ctx := srv.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-j.done:
+			info, progress := j.snapshot()
+			return srv.Send(&imagesv1.JobWatchResponse{Job: info, Progress: progress})
+		case <-time.After(100 * time.Millisecond):
+			info, progress := j.snapshot()
+			if err := srv.Send(&imagesv1.JobWatchResponse{Job: info, Progress: progress}); err != nil {
+				logrus.Debugf("job-watch-send-error: %s -> %v", info.Id, err)
+				return err
+			}
+		}
+	}
+}
+
+// watchBuild relays the buildkit status of a build job, which is replayed from the start of the build.
+func (s *jobsServer) watchBuild(j *job, srv imagesv1.Jobs_WatchServer) error {
+	info, _ := j.snapshot()
+	stream, err := s.ControlService().Status(srv.Context(), &controlapi.StatusRequest{Ref: info.Id})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		info, _ = j.snapshot()
+		err = srv.Send(&imagesv1.JobWatchResponse{
+			Job: info,
+			Build: &imagesv1.ImageBuildStatusResponse{
+				Vertexes: res.Vertexes,
+				Statuses: res.Statuses,
+				Logs:     res.Logs,
+			},
+		})
+		if err != nil {
+			logrus.Debugf("job-watch-send-error: %s -> %v", info.Id, err)
+			return err
+		}
+	}
+}
+
+// Cancel job server-side impl
+func (s *jobsServer) Cancel(ctx context.Context, req *imagesv1.JobCancelRequest) (*imagesv1.JobCancelResponse, error) {
+	logrus.Debugf("job-cancel: %#v", req)
+	j, err := s.jobs.get(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	// the jobs of other users may only be cancelled by those who may cancel any job
+	if info, _ := j.snapshot(); info.Owner != s.user(ctx) {
+		if err = s.authorize(ctx, "jobs", "cancel"); err != nil {
+			return nil, err
+		}
+	}
+	j.mu.Lock()
+	running := j.info.State == jobRunning
+	if running {
+		j.cancelled = true
+	}
+	j.mu.Unlock()
+	if !running {
+		info, _ := j.snapshot()
+		return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrFailedPrecondition, "job %q is %s", req.Id, info.State))
+	}
+	j.cancel()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-j.done:
+	}
+	info, _ := j.snapshot()
+	return &imagesv1.JobCancelResponse{Job: info}, nil
+}
+
+// jobProgress relays the progress of the pull or push job with the requested id, or for clients that do not send
+// request ids the running job for the image, until the job is finished.
+func (s *Server) jobProgress(ctx context.Context, kind string, req *imagesv1.ImageProgressRequest, send func(*imagesv1.ImageProgressResponse) error) error {
+	var j *job
+	if req.RequestId != "" {
+		var err error
+		if j, err = s.jobs.wait(ctx, req.RequestId); err != nil {
+			return err
+		}
+	} else if j = s.jobs.find(kind, req.Image); j == nil {
+		logrus.Debugf("%s-progress-untracked: %s", kind, req.Image)
+		return nil
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-j.done:
+			_, progress := j.snapshot()
+			return send(&imagesv1.ImageProgressResponse{Status: progress})
+		case <-time.After(100 * time.Millisecond):
+			_, progress := j.snapshot()
+			if len(progress) == 0 {
+				continue
+			}
+			if err := send(&imagesv1.ImageProgressResponse{Status: progress}); err != nil {
+				return err
+			}
+		}
+	}
+}
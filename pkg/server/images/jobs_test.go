@@ -0,0 +1,36 @@
+package images
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestJobsFindWhileFinishing(t *testing.T) {
+	s := &Server{}
+	var started []*job
+	for _, id := range []string{"a", "b", "c"} {
+		_, j, err := s.startJob(context.Background(), jobPull, id, "docker.io/library/busybox:latest", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		started = append(started, j)
+	}
+	var wg sync.WaitGroup
+	for _, j := range started {
+		wg.Add(2)
+		go func(j *job) {
+			defer wg.Done()
+			j.finish(nil)
+		}(j)
+		go func() {
+			defer wg.Done()
+			s.jobs.find(jobPull, "docker.io/library/busybox:latest")
+		}()
+	}
+	wg.Wait()
+	if j := s.jobs.find(jobPull, "docker.io/library/busybox:latest"); j != nil {
+		info, _ := j.snapshot()
+		t.Errorf("found finished job %s in state %s", info.Id, info.State)
+	}
+}
@@ -3,17 +3,39 @@ package images
 import (
 	"context"
 
+	"github.com/containerd/containerd/namespaces"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
 	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
 )
 
 // List images server-side impl
 func (s *Server) List(ctx context.Context, req *imagesv1.ImageListRequest) (*imagesv1.ImageListResponse, error) {
+	logrus.Debugf("image-list: %#v", req)
+	filters, err := parseImageFilters(req.Filters)
+	if err != nil {
+		return nil, err
+	}
 	res, err := s.ImageService().ListImages(ctx, &criv1.ListImagesRequest{Filter: req.Filter})
 	if err != nil {
 		return nil, err
 	}
+	ctx = namespaces.WithNamespace(ctx, "k8s.io")
+	metadata := map[string]*imagesv1.ImageMetadata{}
+	for _, image := range res.Images {
+		metadata[image.Id] = s.imageMetadata(ctx, image)
+	}
+	images, err := filterImages(res.Images, metadata, filters, req.References)
+	if err != nil {
+		return nil, err
+	}
+	// only return metadata for the images that made it through the filters
+	result := map[string]*imagesv1.ImageMetadata{}
+	for _, image := range images {
+		result[image.Id] = metadata[image.Id]
+	}
 	return &imagesv1.ImageListResponse{
-		Images: res.Images,
+		Images:   images,
+		Metadata: result,
 	}, nil
 }
@@ -0,0 +1,219 @@
+package images
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/platforms"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/sirupsen/logrus"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+)
+
+// the namespace that buildkit exports images to, which are then mirrored to k8s.io by the agent
+const buildkitNamespace = "buildkit"
+
+// Prune images server-side impl
+func (s *Server) Prune(ctx context.Context, req *imagesv1.ImagePruneRequest) (*imagesv1.ImagePruneResponse, error) {
+	logrus.Debugf("image-prune: %#v", req)
+	filters, err := parseImageFilters(req.Filters)
+	if err != nil {
+		return nil, err
+	}
+	for key := range filters {
+		if key != "until" && key != "label" {
+			return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrInvalidArgument, "invalid filter %q for prune", key))
+		}
+	}
+	if !req.All {
+		filters["dangling"] = []string{"true"}
+	}
+	res := &imagesv1.ImagePruneResponse{}
+
+	// k8s.io: images matching the filters that are not in use by a container
+	k8sCtx := namespaces.WithNamespace(ctx, "k8s.io")
+	list, err := s.ImageService().ListImages(k8sCtx, &criv1.ListImagesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]*imagesv1.ImageMetadata{}
+	for _, image := range list.Images {
+		metadata[image.Id] = s.imageMetadata(k8sCtx, image)
+	}
+	candidates, err := filterImages(list.Images, metadata, filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	k8sImages, err := s.namespaceImages(k8sCtx)
+	if err != nil {
+		return nil, err
+	}
+	inUse, err := s.imagesInUse(k8sCtx, k8sImages)
+	if err != nil {
+		return nil, err
+	}
+	prune := map[string]bool{}
+	for _, image := range candidates {
+		if inUse[image.Id] {
+			logrus.Debugf("image-prune: skipping %s, in use by a container", image.Id)
+			continue
+		}
+		prune[image.Id] = true
+	}
+	if err = s.pruneNamespace(k8sCtx, k8sImages, prune, req.DryRun, res); err != nil {
+		return nil, err
+	}
+
+	// buildkit: images whose mirror in k8s.io no longer exists
+	bkCtx := namespaces.WithNamespace(ctx, buildkitNamespace)
+	bkImages, err := s.namespaceImages(bkCtx)
+	if err != nil {
+		return nil, err
+	}
+	remaining := map[string]bool{}
+	for _, img := range k8sImages {
+		if !prune[img.id] {
+			remaining[img.Name] = true
+		}
+	}
+	prune = map[string]bool{}
+	for _, img := range bkImages {
+		if !remaining[img.Name] {
+			prune[img.id] = true
+		}
+	}
+	for _, img := range bkImages {
+		if remaining[img.Name] {
+			delete(prune, img.id) // another name for the same image is still mirrored
+		}
+	}
+	if err = s.pruneNamespace(bkCtx, bkImages, prune, req.DryRun, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// namespaceImage is a containerd image record along with the id the CRI knows it by.
+type namespaceImage struct {
+	images.Image
+	id string
+}
+
+// namespaceImages lists the images in the namespace of the context, resolving their ids.
+func (s *Server) namespaceImages(ctx context.Context) ([]namespaceImage, error) {
+	list, err := s.Containerd.ImageService().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	store := s.Containerd.ContentStore()
+	var result []namespaceImage
+	for _, img := range list {
+		config, err := img.Config(ctx, store, platforms.Default())
+		if err != nil {
+			logrus.Debugf("namespace-images: failed to resolve config for %s: %v", img.Name, err)
+			continue
+		}
+		result = append(result, namespaceImage{Image: img, id: config.Digest.String()})
+	}
+	return result, nil
+}
+
+// imagesInUse returns the ids of images referenced by containers in the namespace of the context. Containerd records
+// exist for all CRI containers, running or not, including pod sandboxes.
+func (s *Server) imagesInUse(ctx context.Context, nsImages []namespaceImage) (map[string]bool, error) {
+	ids := map[string]string{}
+	for _, img := range nsImages {
+		ids[img.Name] = img.id
+	}
+	containers, err := s.Containerd.ContainerService().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inUse := map[string]bool{}
+	for _, container := range containers {
+		if id, ok := ids[container.Image]; ok {
+			inUse[id] = true
+		} else {
+			inUse[container.Image] = true
+		}
+	}
+	return inUse, nil
+}
+
+// pruneNamespace removes all references to the images with the given ids, recording them and the content that is
+// no longer referenced by the remaining images in the response.
+func (s *Server) pruneNamespace(ctx context.Context, nsImages []namespaceImage, prune map[string]bool, dryRun bool, res *imagesv1.ImagePruneResponse) error {
+	if len(prune) == 0 {
+		return nil
+	}
+	namespace, _ := namespaces.Namespace(ctx)
+	pruned := map[string]*imagesv1.ImagePruned{}
+	kept := map[digest.Digest]bool{}
+	removed := map[digest.Digest]int64{}
+	for _, img := range nsImages {
+		blobs, err := s.imageContent(ctx, img.Image)
+		if err != nil {
+			return err
+		}
+		if !prune[img.id] {
+			for dgst := range blobs {
+				kept[dgst] = true
+			}
+			continue
+		}
+		for dgst, size := range blobs {
+			removed[dgst] = size
+		}
+		p, ok := pruned[img.id]
+		if !ok {
+			p = &imagesv1.ImagePruned{Namespace: namespace, Id: img.id}
+			pruned[img.id] = p
+			res.Images = append(res.Images, p)
+		}
+		p.Refs = append(p.Refs, img.Name)
+	}
+	for dgst, size := range removed {
+		if !kept[dgst] {
+			res.SpaceReclaimed += size
+		}
+	}
+	if dryRun {
+		return nil
+	}
+	for _, p := range pruned {
+		for _, ref := range p.Refs {
+			logrus.Debugf("image-prune: namespace=%s, ref=%s", namespace, ref)
+			err := s.Containerd.ImageService().Delete(ctx, ref, images.SynchronousDelete())
+			if err != nil && !errdefs.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// imageContent returns the sizes of the blobs referenced by the image that are present in the content store.
+func (s *Server) imageContent(ctx context.Context, img images.Image) (map[digest.Digest]int64, error) {
+	store := s.Containerd.ContentStore()
+	blobs := map[digest.Digest]int64{}
+	handler := images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		info, err := store.Info(ctx, desc.Digest)
+		if errdefs.IsNotFound(err) {
+			return nil, nil // not pulled, e.g. manifests for other platforms
+		}
+		if err != nil {
+			return nil, err
+		}
+		blobs[desc.Digest] = info.Size
+		return images.Children(ctx, store, desc)
+	})
+	if err := images.Walk(ctx, handler, img.Target); err != nil {
+		return nil, err
+	}
+	return blobs, nil
+}
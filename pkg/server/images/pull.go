@@ -3,48 +3,70 @@ package images
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
+	"github.com/rancher/kim/pkg/progress"
 	"github.com/rancher/kim/pkg/version"
 	"github.com/sirupsen/logrus"
 	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
 )
 
 // Pull server-side impl
-func (s *Server) Pull(ctx context.Context, req *imagesv1.ImagePullRequest) (*imagesv1.ImagePullResponse, error) {
+func (s *Server) Pull(ctx context.Context, req *imagesv1.ImagePullRequest) (_ *imagesv1.ImagePullResponse, err error) {
 	logrus.Debugf("image-pull: %#v", req)
-	var err error
+	ctx, j, err := s.startJob(ctx, jobPull, req.RequestId, req.Image.Image, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		j.finish(err)
+	}()
+	statusCtx := namespaces.WithNamespace(context.Background(), "k8s.io")
+	tracker := progress.NewPullTracker(ctx, statusCtx, req.Image.Image, s.Containerd.ContentStore(), s.Containerd.SnapshotService(containerd.DefaultSnapshotter))
+	j.track(tracker.Status())
 	if req.Image.Annotations != nil && req.Image.Annotations["images.cattle.io/pull-backend"] == "cri" {
 		err = s.pullCRI(ctx, req.Image, req.Auth)
 	} else {
-		err = s.pullCTD(ctx, req.Image, req.Auth)
+		err = s.pullCTD(ctx, req.Image, req.Auth, tracker)
 	}
 	if err != nil {
 		return nil, err
 	}
+	tracker.Done()
 	return &imagesv1.ImagePullResponse{
 		Image: req.Image.Image,
+		JobId: j.info.Id,
 	}, nil
 }
 
 // pullCTD attempts to pull via containerd directly
-func (s *Server) pullCTD(ctx context.Context, image *criv1.ImageSpec, auth *criv1.AuthConfig) error {
+func (s *Server) pullCTD(ctx context.Context, image *criv1.ImageSpec, auth *criv1.AuthConfig, tracker progress.PullTracker) error {
 	ctx = namespaces.WithNamespace(ctx, "k8s.io")
-	resolver := Resolver(auth, nil)
+	resolver, err := s.Resolver(auth, nil)
+	if err != nil {
+		return err
+	}
 	platform := platforms.DefaultString()
 	if image.Annotations != nil {
 		platform = image.Annotations["images.cattle.io/pull-platform"]
 	}
-	_, err := s.Containerd.Pull(ctx, image.Image,
+	handler := images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		tracker.Add(remotes.MakeRefKey(ctx, desc), desc)
+		return nil, nil
+	})
+	_, err = s.Containerd.Pull(ctx, image.Image,
 		containerd.WithPullUnpack,
 		containerd.WithSchema1Conversion,
 		containerd.WithPullLabel("io.cattle.images/client", fmt.Sprintf("kim/%s", version.Version)),
 		containerd.WithResolver(resolver),
 		containerd.WithPlatform(platform),
+		containerd.WithImageHandler(handler),
 	)
 	return err
 }
@@ -62,52 +84,5 @@ func (s *Server) pullCRI(ctx context.Context, image *criv1.ImageSpec, auth *criv
 // PullProgress server-side impl
 func (s *Server) PullProgress(req *imagesv1.ImageProgressRequest, srv imagesv1.Images_PullProgressServer) error {
 	logrus.Debugf("image-pull-progress: %#v", req)
-	ctx := namespaces.WithNamespace(srv.Context(), "k8s.io")
-
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(100 * time.Millisecond):
-			isr, err := s.ImageService().ImageStatus(ctx, &criv1.ImageStatusRequest{
-				Image: &criv1.ImageSpec{
-					Image: req.Image,
-				},
-			})
-			if err != nil {
-				logrus.Debugf("pull-progress-image-status-error: %v", err)
-				return err
-			}
-			if isr.Image != nil {
-				logrus.Debugf("pull-progress-image-status-done: %s", isr.Image)
-				return nil
-			}
-			csl, err := s.Containerd.ContentStore().ListStatuses(ctx, "") // TODO is this filter too broad?
-			if err != nil {
-				logrus.Debugf("pull-progress-content-status-error: %v", err)
-				return err
-			}
-			res := &imagesv1.ImageProgressResponse{}
-			for _, s := range csl {
-				status := "waiting"
-				if s.Offset == s.Total {
-					status = "unpacking"
-				} else if s.Offset > 0 {
-					status = "downloading"
-				}
-				res.Status = append(res.Status, imagesv1.ImageStatus{
-					Status:    status,
-					Ref:       s.Ref,
-					Offset:    s.Offset,
-					Total:     s.Total,
-					StartedAt: s.StartedAt,
-					UpdatedAt: s.UpdatedAt,
-				})
-			}
-			if err = srv.Send(res); err != nil {
-				logrus.Debugf("pull-progress-content-send-error: %v", err)
-				return err
-			}
-		}
-	}
+	return s.jobProgress(srv.Context(), jobPull, req, srv.Send)
 }
@@ -2,7 +2,6 @@ package images
 
 import (
 	"context"
-	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/images"
@@ -20,16 +19,27 @@ var (
 )
 
 // Push server-side impl
-func (s *Server) Push(ctx context.Context, req *imagesv1.ImagePushRequest) (*imagesv1.ImagePushResponse, error) {
+func (s *Server) Push(ctx context.Context, req *imagesv1.ImagePushRequest) (_ *imagesv1.ImagePushResponse, err error) {
 	ctx = namespaces.WithNamespace(ctx, "k8s.io")
 	img, err := s.Containerd.ImageService().Get(ctx, req.Image.Image)
 	if err != nil {
 		return nil, err
 	}
+	ctx, j, err := s.startJob(ctx, jobPush, req.RequestId, img.Name, true)
+	if err != nil {
+		return nil, err
+	}
+	ctx = namespaces.WithNamespace(ctx, "k8s.io")
+	defer func() {
+		j.finish(err)
+	}()
 
-	resolver := Resolver(req.Auth, PushTracker)
+	resolver, err := s.Resolver(req.Auth, PushTracker)
+	if err != nil {
+		return nil, err
+	}
 	tracker := progress.NewTracker(ctx, PushTracker)
-	s.pushJobs.Store(img.Name, tracker)
+	j.track(tracker.Status())
 	handler := images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
 		tracker.Add(remotes.MakeRefKey(ctx, desc))
 		return nil, nil
@@ -43,34 +53,12 @@ func (s *Server) Push(ctx context.Context, req *imagesv1.ImagePushRequest) (*ima
 	}
 	return &imagesv1.ImagePushResponse{
 		Image: img.Name,
+		JobId: j.info.Id,
 	}, nil
 }
 
 // PushProgress server-side impl
 func (s *Server) PushProgress(req *imagesv1.ImageProgressRequest, srv imagesv1.Images_PushProgressServer) error {
-	ctx := namespaces.WithNamespace(srv.Context(), "k8s.io")
-	defer s.pushJobs.Delete(req.Image)
-
-	timeout := time.After(15 * time.Second)
-
-	for {
-		if tracker, tracking := s.pushJobs.Load(req.Image); tracking {
-			for status := range tracker.(progress.Tracker).Status() {
-				if err := srv.Send(&imagesv1.ImageProgressResponse{Status: status}); err != nil {
-					logrus.Debugf("push-progress-error: %s -> %v", req.Image, err)
-					return err
-				}
-			}
-			logrus.Debugf("push-progress-done: %s", req.Image)
-			return nil
-		}
-		select {
-		case <-timeout:
-			logrus.Debugf("push-progress-timeout: not tracking %s", req.Image)
-			return nil
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(100 * time.Millisecond):
-		}
-	}
+	logrus.Debugf("image-push-progress: %#v", req)
+	return s.jobProgress(srv.Context(), jobPush, req, srv.Send)
 }
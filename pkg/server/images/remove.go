@@ -2,13 +2,17 @@ package images
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/namespaces"
+	"github.com/docker/distribution/reference"
+	"github.com/pkg/errors"
 	imagesv1 "github.com/rancher/kim/pkg/apis/services/images/v1alpha1"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
 )
 
 // Remove image server-side impl
@@ -19,32 +23,112 @@ func (s *Server) Remove(ctx context.Context, req *imagesv1.ImageRemoveRequest) (
 		return nil, err
 	}
 	defer done(ctx)
-	if req.Image == nil {
-		return &imagesv1.ImageRemoveResponse{}, nil
+	specs := req.Images
+	if req.Image != nil {
+		specs = append([]*criv1.ImageSpec{req.Image}, specs...)
 	}
-	img, err := s.Containerd.ImageService().Get(ctx, req.Image.Image)
+	res := &imagesv1.ImageRemoveResponse{}
+	if len(specs) == 0 {
+		return res, nil
+	}
+	nsImages, err := s.namespaceImages(ctx)
 	if err != nil {
 		return nil, err
 	}
-	refs := []string{img.Name}
-	tags, err := s.Containerd.ImageService().List(ctx, fmt.Sprintf("target.digest==%s,name!=%s", img.Target.Digest, img.Name))
+	inUse, err := s.imagesInUse(ctx, nsImages)
 	if err != nil {
 		return nil, err
 	}
-	switch {
-	case len(tags) == 1: // single tag
-		refs = append(refs, tags[0].Name)
-	case strings.HasPrefix(img.Name, "sha256:"): // image id
-		for _, tag := range tags {
-			refs = append(refs, tag.Name)
+	for _, spec := range specs {
+		result := &imagesv1.ImageRemoveResult{Image: spec}
+		if err = s.remove(ctx, spec.Image, req.Force, inUse, result); err != nil {
+			st := status.Convert(errdefs.ToGRPC(err))
+			result.Code, result.Error = int32(st.Code()), st.Message()
 		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+// remove untags the image if it is referenced by other tags, otherwise deletes it along with all of its references.
+func (s *Server) remove(ctx context.Context, name string, force bool, inUse map[string]bool, result *imagesv1.ImageRemoveResult) error {
+	img, err := s.Containerd.ImageService().Get(ctx, name)
+	if err != nil {
+		return errors.Wrapf(err, "image %q", name)
+	}
+	// listing again as previously requested images may have removed references
+	nsImages, err := s.namespaceImages(ctx)
+	if err != nil {
+		return err
+	}
+	id := img.Target.Digest.String()
+	for _, nsImage := range nsImages {
+		if nsImage.Name == img.Name {
+			id = nsImage.id
+		}
+	}
+	refs := []string{img.Name}
+	var otherTags int
+	repositories := map[string]bool{}
+	for _, nsImage := range nsImages {
+		if nsImage.id != id || nsImage.Name == img.Name {
+			continue
+		}
+		refs = append(refs, nsImage.Name)
+		named, err := reference.ParseNormalizedNamed(nsImage.Name)
+		if err != nil {
+			continue // image id
+		}
+		repositories[named.Name()] = true
+		if _, tagged := named.(reference.Tagged); tagged {
+			otherTags++
+		}
+	}
+	byID := strings.HasPrefix(img.Name, "sha256:")
+	if named, err := reference.ParseNormalizedNamed(img.Name); err == nil && !byID {
+		repositories[named.Name()] = true
+	}
+
+	// untag only
+	if !byID && otherTags > 0 {
+		logrus.Debugf("image-remove: untag ref=%s", img.Name)
+		if err = s.Containerd.ImageService().Delete(ctx, img.Name); err != nil {
+			return err
+		}
+		result.Untagged = append(result.Untagged, img.Name)
+		return nil
+	}
+
+	if !force {
+		if byID && len(repositories) > 1 {
+			return errors.Wrapf(errdefs.ErrFailedPrecondition, "unable to delete %s (must be forced) - image is referenced in multiple repositories", name)
+		}
+		if inUse[id] {
+			return errors.Wrapf(errdefs.ErrFailedPrecondition, "unable to delete %s (must be forced) - image is in use by a container", name)
+		}
+	}
+	// forced removal of an image in use by a container only untags it, the image and its other references remain
+	if inUse[id] {
+		if byID {
+			return errors.Wrapf(errdefs.ErrFailedPrecondition, "unable to delete %s (cannot be forced) - image is in use by a container", name)
+		}
+		logrus.Debugf("image-remove: untag in-use ref=%s, id=%s", img.Name, id)
+		if err = s.Containerd.ImageService().Delete(ctx, img.Name); err != nil {
+			return err
+		}
+		result.Untagged = append(result.Untagged, img.Name)
+		return nil
 	}
 	for _, ref := range refs {
-		logrus.Debugf("image-remove: ref=%s, img=%#v", ref, req.Image)
+		logrus.Debugf("image-remove: ref=%s, id=%s", ref, id)
 		err = s.Containerd.ImageService().Delete(ctx, ref, images.SynchronousDelete())
-		if err != nil {
-			return nil, err
+		if err != nil && !errdefs.IsNotFound(err) {
+			return err
+		}
+		if !strings.HasPrefix(ref, "sha256:") {
+			result.Untagged = append(result.Untagged, ref)
 		}
 	}
-	return &imagesv1.ImageRemoveResponse{}, nil
+	result.Deleted = id
+	return nil
 }
@@ -0,0 +1,416 @@
+package images
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/archive/compression"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// squash rewrites the images exported by buildkit such that the layers created by the build are merged into a single
+// layer. Layers are attributed to the build when the history entries that created them are no older than since. The
+// images are updated in the buildkit namespace and from there mirrored to k8s.io like any other export.
+func (s *Server) squash(ctx context.Context, names []string, since time.Time, exporterResponse map[string]string) error {
+	ctx, done, err := s.Containerd.WithLease(namespaces.WithNamespace(ctx, buildkitNamespace))
+	if err != nil {
+		return err
+	}
+	defer done(ctx)
+	sq := &squasher{
+		store: s.Containerd.ContentStore(),
+		since: since.Truncate(time.Second),
+	}
+	squashed := map[digest.Digest]ocispec.Descriptor{}
+	for _, name := range names {
+		img, err := s.Containerd.ImageService().Get(ctx, name)
+		if err != nil {
+			return err
+		}
+		target, ok := squashed[img.Target.Digest]
+		if !ok {
+			if target, err = sq.descriptor(ctx, img.Target); err != nil {
+				return errors.Wrapf(err, "failed to squash %s", name)
+			}
+			squashed[img.Target.Digest] = target
+		}
+		logrus.Debugf("image-squash: name=%s, target=%s", name, target.Digest)
+		previous := img.Target
+		img.Target = target
+		if _, err = s.Containerd.ImageService().Update(ctx, img, "target"); err != nil {
+			return err
+		}
+		if err = s.squashCanonical(ctx, name, previous, target); err != nil {
+			return err
+		}
+		exporterResponse["containerimage.digest"] = target.Digest.String()
+		if sq.config != nil {
+			exporterResponse["containerimage.config.digest"] = digest.FromBytes(sq.config).String()
+			exporterResponse["containerimage.config"] = base64.StdEncoding.EncodeToString(sq.config)
+		}
+	}
+	return nil
+}
+
+// squashCanonical replaces the canonical reference, name@digest, created by the exporter for the unsquashed image.
+func (s *Server) squashCanonical(ctx context.Context, name string, previous, target ocispec.Descriptor) error {
+	if previous.Digest == target.Digest {
+		return nil
+	}
+	imageStore := s.Containerd.ImageService()
+	img, err := imageStore.Get(ctx, name+"@"+previous.Digest.String())
+	if errdefs.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err = imageStore.Delete(ctx, img.Name); err != nil && !errdefs.IsNotFound(err) {
+		return err
+	}
+	// deletes are not mirrored
+	if err = imageStore.Delete(namespaces.WithNamespace(ctx, "k8s.io"), img.Name); err != nil && !errdefs.IsNotFound(err) {
+		return err
+	}
+	img.Name = name + "@" + target.Digest.String()
+	img.Target = target
+	if _, err = imageStore.Create(ctx, img); err != nil && !errdefs.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+type squasher struct {
+	store content.Store
+	since time.Time
+	// config of the last squashed manifest
+	config []byte
+}
+
+// descriptor squashes the manifest, or each of the manifests of the index, returning the descriptor of the result.
+func (sq *squasher) descriptor(ctx context.Context, desc ocispec.Descriptor) (ocispec.Descriptor, error) {
+	switch {
+	case images.IsManifestType(desc.MediaType):
+		return sq.manifest(ctx, desc)
+	case images.IsIndexType(desc.MediaType):
+		p, err := content.ReadBlob(ctx, sq.store, desc)
+		if err != nil {
+			return desc, err
+		}
+		var raw map[string]json.RawMessage
+		if err = json.Unmarshal(p, &raw); err != nil {
+			return desc, err
+		}
+		var index ocispec.Index
+		if err = json.Unmarshal(p, &index); err != nil {
+			return desc, err
+		}
+		labels := map[string]string{}
+		for i, m := range index.Manifests {
+			if _, err := sq.store.Info(ctx, m.Digest); errdefs.IsNotFound(err) {
+				continue // not present, e.g. attestations or other platforms
+			}
+			if index.Manifests[i], err = sq.manifest(ctx, m); err != nil {
+				return desc, err
+			}
+			labels[fmt.Sprintf("containerd.io/gc.ref.content.m.%d", i)] = index.Manifests[i].Digest.String()
+		}
+		if raw["manifests"], err = json.Marshal(index.Manifests); err != nil {
+			return desc, err
+		}
+		// the config of an index is ambiguous
+		sq.config = nil
+		return sq.write(ctx, desc, raw, labels)
+	default:
+		return desc, errors.Errorf("unexpected media type %v for %v", desc.MediaType, desc.Digest)
+	}
+}
+
+// manifest squashes the layers of the manifest attributed to the build, rewriting the config and manifest.
+func (sq *squasher) manifest(ctx context.Context, desc ocispec.Descriptor) (ocispec.Descriptor, error) {
+	p, err := content.ReadBlob(ctx, sq.store, desc)
+	if err != nil {
+		return desc, err
+	}
+	var rawManifest map[string]json.RawMessage
+	if err = json.Unmarshal(p, &rawManifest); err != nil {
+		return desc, err
+	}
+	var manifest ocispec.Manifest
+	if err = json.Unmarshal(p, &manifest); err != nil {
+		return desc, err
+	}
+	if sq.config, err = content.ReadBlob(ctx, sq.store, manifest.Config); err != nil {
+		return desc, err
+	}
+	var rawConfig map[string]json.RawMessage
+	if err = json.Unmarshal(sq.config, &rawConfig); err != nil {
+		return desc, err
+	}
+	var config ocispec.Image
+	if err = json.Unmarshal(sq.config, &config); err != nil {
+		return desc, err
+	}
+
+	// history entries, and the layers they created, attributed to the build
+	first := len(config.History)
+	for first > 0 && config.History[first-1].Created != nil && !config.History[first-1].Created.Before(sq.since) {
+		first--
+	}
+	var layers int
+	for _, h := range config.History[first:] {
+		if !h.EmptyLayer {
+			layers++
+		}
+	}
+	if layers < 2 {
+		logrus.Debugf("image-squash: nothing to squash for %s", desc.Digest)
+		return desc, nil
+	}
+	base := len(manifest.Layers) - layers
+	if base < 0 || len(config.RootFS.DiffIDs) != len(manifest.Layers) {
+		return desc, errors.Errorf("history of %s does not match its layers", manifest.Config.Digest)
+	}
+	mediaType := ocispec.MediaTypeImageLayerGzip
+	if images.IsDockerType(manifest.Layers[len(manifest.Layers)-1].MediaType) {
+		mediaType = images.MediaTypeDockerSchema2LayerGzip
+	}
+	layer, diffID, err := sq.layers(ctx, manifest.Layers[base:], mediaType)
+	if err != nil {
+		return desc, err
+	}
+
+	// config, retaining the history of the squashed layers
+	for i := first; i < len(config.History); i++ {
+		config.History[i].EmptyLayer = true
+	}
+	created := time.Now().UTC()
+	config.History = append(config.History, ocispec.History{
+		Created:   &created,
+		CreatedBy: "kim build --squash",
+		Comment:   fmt.Sprintf("merge %s to %s", config.RootFS.DiffIDs[base], config.RootFS.DiffIDs[len(config.RootFS.DiffIDs)-1]),
+	})
+	config.RootFS.DiffIDs = append(config.RootFS.DiffIDs[:base:base], diffID)
+	if rawConfig["history"], err = json.Marshal(config.History); err != nil {
+		return desc, err
+	}
+	if rawConfig["rootfs"], err = json.Marshal(config.RootFS); err != nil {
+		return desc, err
+	}
+	if sq.config, err = json.Marshal(rawConfig); err != nil {
+		return desc, err
+	}
+	configDesc := ocispec.Descriptor{
+		MediaType: manifest.Config.MediaType,
+		Digest:    digest.FromBytes(sq.config),
+		Size:      int64(len(sq.config)),
+	}
+	if err = content.WriteBlob(ctx, sq.store, "kim-squash-"+configDesc.Digest.String(), bytes.NewReader(sq.config), configDesc); err != nil {
+		return desc, err
+	}
+
+	// manifest
+	manifest.Layers = append(manifest.Layers[:base:base], layer)
+	if rawManifest["config"], err = json.Marshal(configDesc); err != nil {
+		return desc, err
+	}
+	if rawManifest["layers"], err = json.Marshal(manifest.Layers); err != nil {
+		return desc, err
+	}
+	labels := map[string]string{
+		"containerd.io/gc.ref.content.config": configDesc.Digest.String(),
+	}
+	for i, l := range manifest.Layers {
+		labels[fmt.Sprintf("containerd.io/gc.ref.content.l.%d", i)] = l.Digest.String()
+	}
+	return sq.write(ctx, desc, rawManifest, labels)
+}
+
+// write the raw json as the replacement for desc
+func (sq *squasher) write(ctx context.Context, desc ocispec.Descriptor, raw map[string]json.RawMessage, labels map[string]string) (ocispec.Descriptor, error) {
+	p, err := json.Marshal(raw)
+	if err != nil {
+		return desc, err
+	}
+	result := ocispec.Descriptor{
+		MediaType:   desc.MediaType,
+		Digest:      digest.FromBytes(p),
+		Size:        int64(len(p)),
+		Platform:    desc.Platform,
+		Annotations: desc.Annotations,
+	}
+	err = content.WriteBlob(ctx, sq.store, "kim-squash-"+result.Digest.String(), bytes.NewReader(p), result, content.WithLabels(labels))
+	return result, err
+}
+
+type squashEntry struct {
+	header *tar.Header
+	file   string
+	layer  int
+	order  int
+}
+
+// layers merges the layers into a single gzip-compressed layer, returning its descriptor and diff id. whiteouts are
+// applied to the entries of preceding layers and retained for the layers below.
+func (sq *squasher) layers(ctx context.Context, layers []ocispec.Descriptor, mediaType string) (ocispec.Descriptor, digest.Digest, error) {
+	tmp, err := ioutil.TempDir("", "kim-squash-*")
+	if err != nil {
+		return ocispec.Descriptor{}, "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	entries := map[string]*squashEntry{}
+	// remove entries at or below the path, added by preceding layers
+	remove := func(name string, layer int, inclusive bool) {
+		for p, e := range entries {
+			if e.layer < layer && ((inclusive && p == name) || strings.HasPrefix(p, name+"/")) {
+				if e.file != "" {
+					os.Remove(e.file)
+				}
+				delete(entries, p)
+			}
+		}
+	}
+	var order, files int
+	for i, layer := range layers {
+		err := func() error {
+			ra, err := sq.store.ReaderAt(ctx, layer)
+			if err != nil {
+				return err
+			}
+			defer ra.Close()
+			rc, err := compression.DecompressStream(content.NewReader(ra))
+			if err != nil {
+				return err
+			}
+			defer rc.Close()
+			tr := tar.NewReader(rc)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				name := path.Clean("/" + hdr.Name)
+				dir, base := path.Split(name)
+				switch {
+				case base == ".wh..wh..opq":
+					remove(path.Clean(dir), i, false)
+				case strings.HasPrefix(base, ".wh."):
+					remove(path.Join(dir, strings.TrimPrefix(base, ".wh.")), i, true)
+				}
+				if existing, ok := entries[name]; ok && existing.header.Typeflag == tar.TypeDir && hdr.Typeflag != tar.TypeDir {
+					remove(name, i, false) // replacing a directory
+				}
+				entry := &squashEntry{header: hdr, layer: i, order: order}
+				if existing, ok := entries[name]; ok {
+					entry.order = existing.order
+					if existing.file != "" {
+						os.Remove(existing.file)
+					}
+				} else {
+					order++
+				}
+				if hdr.Typeflag == tar.TypeReg || hdr.Typeflag == tar.TypeRegA {
+					files++
+					entry.file = filepath.Join(tmp, strconv.Itoa(files))
+					f, err := os.Create(entry.file)
+					if err != nil {
+						return err
+					}
+					_, err = io.Copy(f, tr)
+					f.Close()
+					if err != nil {
+						return err
+					}
+				}
+				entries[name] = entry
+			}
+		}()
+		if err != nil {
+			return ocispec.Descriptor{}, "", errors.Wrapf(err, "failed to read layer %s", layer.Digest)
+		}
+	}
+
+	// entries in order of first appearance, such that parent directories and hardlink targets come first
+	sorted := make([]*squashEntry, 0, len(entries))
+	for _, e := range entries {
+		sorted = append(sorted, e)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].order < sorted[j].order
+	})
+
+	ref := fmt.Sprintf("kim-squash-%d", time.Now().UnixNano())
+	w, err := content.OpenWriter(ctx, sq.store, content.WithRef(ref))
+	if err != nil {
+		return ocispec.Descriptor{}, "", err
+	}
+	defer w.Close()
+	uncompressed := digest.Canonical.Digester()
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(io.MultiWriter(gz, uncompressed.Hash()))
+	for _, e := range sorted {
+		if err = tw.WriteHeader(e.header); err != nil {
+			return ocispec.Descriptor{}, "", err
+		}
+		if e.file == "" {
+			continue
+		}
+		f, err := os.Open(e.file)
+		if err != nil {
+			return ocispec.Descriptor{}, "", err
+		}
+		_, err = io.Copy(tw, f)
+		f.Close()
+		if err != nil {
+			return ocispec.Descriptor{}, "", err
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return ocispec.Descriptor{}, "", err
+	}
+	if err = gz.Close(); err != nil {
+		return ocispec.Descriptor{}, "", err
+	}
+	status, err := w.Status()
+	if err != nil {
+		return ocispec.Descriptor{}, "", err
+	}
+	desc := ocispec.Descriptor{
+		MediaType: mediaType,
+		Digest:    w.Digest(),
+		Size:      status.Offset,
+	}
+	diffID := uncompressed.Digest()
+	err = w.Commit(ctx, desc.Size, desc.Digest, content.WithLabels(map[string]string{
+		"containerd.io/uncompressed": diffID.String(),
+	}))
+	if err != nil && !errdefs.IsAlreadyExists(err) {
+		return ocispec.Descriptor{}, "", err
+	}
+	return desc, diffID, nil
+}
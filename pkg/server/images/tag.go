@@ -22,7 +22,7 @@ func (s *Server) Tag(ctx context.Context, req *imagesv1.ImageTagRequest) (*image
 	svc := s.Containerd.ImageService()
 	img, err := svc.Get(ctx, ref)
 	if err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(err)
 	}
 	for _, tag := range req.Tags {
 		img.Name = tag
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// certReloader serves the server cert+key, and verifies clients against the ca cert, as of the files on disk. They are
+// reloaded when they change, e.g. when the secrets mounted at their paths are rotated, so that a restart is not needed.
+type certReloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu      sync.Mutex
+	modTime time.Time
+	config  *tls.Config
+}
+
+func newCertReloader(certFile, keyFile, caFile string) (*certReloader, error) {
+	r := &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+	if _, err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// TLSConfig returns the config to serve with, which defers to the latest certificates for each connection.
+func (r *certReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.load()
+		},
+	}
+}
+
+// load returns the config for the certificates on disk, keeping the previous if those fail to load, e.g. mid-update.
+func (r *certReloader) load() (*tls.Config, error) {
+	modTime, err := r.latestModTime()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.config != nil && (err != nil || modTime.Equal(r.modTime)) {
+		return r.config, nil
+	}
+	config, err := r.read()
+	if err != nil {
+		if r.config != nil {
+			logrus.Warnf("Failed to reload TLS certificates, keeping the previous: %v", err)
+			return r.config, nil
+		}
+		return nil, err
+	}
+	if r.config != nil {
+		logrus.Info("Reloaded TLS certificates")
+	}
+	r.config, r.modTime = config, modTime
+	return config, nil
+}
+
+func (r *certReloader) read() (*tls.Config, error) {
+	serverCert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.NoClientCert,
+		NextProtos:   []string{"h2"},
+	}
+	if r.caFile != "" {
+		caCert, err := ioutil.ReadFile(r.caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = x509.NewCertPool()
+		if ok := config.ClientCAs.AppendCertsFromPEM(caCert); !ok {
+			return nil, errors.New("failed to append ca certificate")
+		}
+	}
+	return config, nil
+}
+
+func (r *certReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}